@@ -0,0 +1,316 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/glifio/filecoin-descriptors/descriptors"
+)
+
+func runDecode(args []string) error {
+	flags := newFlagSet("decode")
+	dir := flags.String("descriptors", "output", "Directory with the descriptors written by the descriptors and codes commands")
+	isReturn := flags.Bool("return", false, "Decode the return value instead of the params")
+	format := flags.String("format", "base64", "Encoding of the CBOR data: base64 or hex")
+	flags.Parse(args)
+
+	actor, methodNum, input, err := getMethodArgs(flags.Args())
+	if err != nil {
+		return err
+	}
+	data, err := decodeBinary(strings.TrimSpace(input), *format)
+	if err != nil {
+		return err
+	}
+
+	d, err := descriptors.LoadDescriptors(*dir)
+	if err != nil {
+		return err
+	}
+
+	var value interface{}
+	if *isReturn {
+		value, err = d.DecodeReturn(actor, methodNum, data)
+	} else {
+		value, err = d.DecodeParams(actor, methodNum, data)
+	}
+	if err != nil {
+		return err
+	}
+
+	valueJson, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(valueJson))
+
+	return nil
+}
+
+func runEncode(args []string) error {
+	flags := newFlagSet("encode")
+	dir := flags.String("descriptors", "output", "Directory with the descriptors written by the descriptors and codes commands")
+	isReturn := flags.Bool("return", false, "Encode the return value instead of the params")
+	format := flags.String("format", "base64", "Encoding of the CBOR data: base64 or hex")
+	flags.Parse(args)
+
+	actor, methodNum, input, err := getMethodArgs(flags.Args())
+	if err != nil {
+		return err
+	}
+
+	d, err := descriptors.LoadDescriptors(*dir)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if *isReturn {
+		data, err = d.EncodeReturn(actor, methodNum, []byte(input))
+	} else {
+		data, err = d.EncodeParams(actor, methodNum, []byte(input))
+	}
+	if err != nil {
+		return err
+	}
+
+	output, err := encodeBinary(data, *format)
+	if err != nil {
+		return err
+	}
+	fmt.Println(output)
+
+	return nil
+}
+
+func runDiff(args []string) error {
+	flags := newFlagSet("diff")
+	dir := flags.String("descriptors", "output", "Directory with the descriptors written by the descriptors command")
+	format := flags.String("format", "text", "Output format: text or json")
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return fmt.Errorf("expected two actors versions")
+	}
+	var versions [2]descriptors.ActorsVersion
+	for i, arg := range flags.Args() {
+		version, err := strconv.ParseUint(strings.TrimPrefix(arg, "v"), 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid actors version %s", arg)
+		}
+		versions[i] = descriptors.ActorsVersion(version)
+	}
+
+	d, err := descriptors.LoadDescriptors(*dir)
+	if err != nil {
+		return err
+	}
+	changes, err := d.Diff(versions[0], versions[1])
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case "text":
+		for _, change := range changes {
+			fmt.Println(change)
+		}
+	case "json":
+		changesJson, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(changesJson))
+	default:
+		return fmt.Errorf("unknown format %s", *format)
+	}
+
+	return nil
+}
+
+func runActor(args []string) error {
+	flags := newFlagSet("actor")
+	dir := flags.String("descriptors", "output", "Directory with the descriptors written by the descriptors and codes commands")
+	lotusFlags := addLotusFlags(flags)
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("expected an address")
+	}
+
+	d, err := descriptors.LoadDescriptors(*dir)
+	if err != nil {
+		return err
+	}
+	lotus, err := lotusFlags.open()
+	if err != nil {
+		return err
+	}
+	defer lotus.Close()
+
+	actor, err := lotus.ResolveActor(context.Background(), d, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	actorJson, err := json.MarshalIndent(actor, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(actorJson))
+
+	return nil
+}
+
+func runState(args []string) error {
+	flags := newFlagSet("state")
+	dir := flags.String("descriptors", "output", "Directory with the descriptors written by the descriptors and codes commands")
+	lotusFlags := addLotusFlags(flags)
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("expected an address")
+	}
+
+	d, err := descriptors.LoadDescriptors(*dir)
+	if err != nil {
+		return err
+	}
+	lotus, err := lotusFlags.open()
+	if err != nil {
+		return err
+	}
+	defer lotus.Close()
+
+	state, err := lotus.ReadActorState(context.Background(), d, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	stateJson, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(stateJson))
+
+	return nil
+}
+
+// Flags of commands that read from a single Lotus node
+type lotusFlags struct {
+	endpoint *string
+	token    *string
+	timeout  *time.Duration
+	retries  *int
+	tipset   stringList
+}
+
+func addLotusFlags(flags *flag.FlagSet) *lotusFlags {
+	var f lotusFlags
+	f.endpoint = flags.String("endpoint", defaultEndpoints[0], "Lotus RPC endpoint")
+	f.token = flags.String("token", "", "Lotus RPC auth token, for endpoints without a token as user info")
+	f.timeout = flags.Duration("timeout", descriptors.DefaultLotusOptions.Timeout, "Deadline of each Lotus RPC call")
+	f.retries = flags.Int("retries", descriptors.DefaultLotusOptions.Retries, "Retries of Lotus RPC calls that fail with a transient error")
+	flags.Var(&f.tipset, "tipset", "Block CIDs of the tipset to read at, may be repeated (default head)")
+	return &f
+}
+
+func (f *lotusFlags) open() (*descriptors.Lotus, error) {
+	url, endpointToken, err := descriptors.ParseEndpoint(*f.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	options := descriptors.DefaultLotusOptions
+	options.Token = *f.token
+	options.Timeout = *f.timeout
+	options.Retries = *f.retries
+	if endpointToken != "" {
+		options.Token = endpointToken
+	}
+	if options.TipSet, err = descriptors.ParseTipSetKey(f.tipset); err != nil {
+		return nil, err
+	}
+
+	var lotus descriptors.Lotus
+	if err := lotus.Open(url, options); err != nil {
+		return nil, err
+	}
+	return &lotus, nil
+}
+
+func runServe(args []string) error {
+	flags := newFlagSet("serve")
+	dir := flags.String("descriptors", "output", "Directory with the descriptors written by the descriptors and codes commands")
+	listen := flags.String("listen", ":8080", "Address to listen on")
+	flags.Parse(args)
+
+	d, err := descriptors.LoadDescriptors(*dir)
+	if err != nil {
+		return err
+	}
+
+	logf("Serving %s on %s", *dir, *listen)
+	server := &http.Server{
+		Addr:              *listen,
+		Handler:           descriptors.NewDescriptorsHandler(d, *dir),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
+	return server.ListenAndServe()
+}
+
+// Returns the actor, method number and data arguments, reading the data
+// from stdin when it is not given
+func getMethodArgs(args []string) (string, abi.MethodNum, string, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return "", 0, "", fmt.Errorf("expected actor, method number and optional data")
+	}
+	methodNum, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return "", 0, "", fmt.Errorf("invalid method number %s", args[1])
+	}
+	if len(args) == 3 {
+		return args[0], abi.MethodNum(methodNum), args[2], nil
+	}
+	input, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", 0, "", err
+	}
+	return args[0], abi.MethodNum(methodNum), string(input), nil
+}
+
+func decodeBinary(input string, format string) ([]byte, error) {
+	switch format {
+	case "base64":
+		return base64.StdEncoding.DecodeString(input)
+	case "hex":
+		return hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	}
+	return nil, fmt.Errorf("unknown format %s", format)
+}
+
+func encodeBinary(data []byte, format string) (string, error) {
+	switch format {
+	case "base64":
+		return base64.StdEncoding.EncodeToString(data), nil
+	case "hex":
+		return hex.EncodeToString(data), nil
+	}
+	return "", fmt.Errorf("unknown format %s", format)
+}
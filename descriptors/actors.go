@@ -0,0 +1,1513 @@
+// Code generated by gen/main.go. DO NOT EDIT.
+
+package descriptors
+
+import (
+	"reflect"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-bitfield"
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/builtin"
+	account10 "github.com/filecoin-project/go-state-types/builtin/v10/account"
+	cron10 "github.com/filecoin-project/go-state-types/builtin/v10/cron"
+	datacap10 "github.com/filecoin-project/go-state-types/builtin/v10/datacap"
+	eam10 "github.com/filecoin-project/go-state-types/builtin/v10/eam"
+	ethaccount10 "github.com/filecoin-project/go-state-types/builtin/v10/ethaccount"
+	evm10 "github.com/filecoin-project/go-state-types/builtin/v10/evm"
+	init10 "github.com/filecoin-project/go-state-types/builtin/v10/init"
+	market10 "github.com/filecoin-project/go-state-types/builtin/v10/market"
+	miner10 "github.com/filecoin-project/go-state-types/builtin/v10/miner"
+	multisig10 "github.com/filecoin-project/go-state-types/builtin/v10/multisig"
+	paych10 "github.com/filecoin-project/go-state-types/builtin/v10/paych"
+	placeholder10 "github.com/filecoin-project/go-state-types/builtin/v10/placeholder"
+	power10 "github.com/filecoin-project/go-state-types/builtin/v10/power"
+	reward10 "github.com/filecoin-project/go-state-types/builtin/v10/reward"
+	system10 "github.com/filecoin-project/go-state-types/builtin/v10/system"
+	verifreg10 "github.com/filecoin-project/go-state-types/builtin/v10/verifreg"
+	account11 "github.com/filecoin-project/go-state-types/builtin/v11/account"
+	cron11 "github.com/filecoin-project/go-state-types/builtin/v11/cron"
+	datacap11 "github.com/filecoin-project/go-state-types/builtin/v11/datacap"
+	eam11 "github.com/filecoin-project/go-state-types/builtin/v11/eam"
+	ethaccount11 "github.com/filecoin-project/go-state-types/builtin/v11/ethaccount"
+	evm11 "github.com/filecoin-project/go-state-types/builtin/v11/evm"
+	init11 "github.com/filecoin-project/go-state-types/builtin/v11/init"
+	market11 "github.com/filecoin-project/go-state-types/builtin/v11/market"
+	miner11 "github.com/filecoin-project/go-state-types/builtin/v11/miner"
+	multisig11 "github.com/filecoin-project/go-state-types/builtin/v11/multisig"
+	paych11 "github.com/filecoin-project/go-state-types/builtin/v11/paych"
+	placeholder11 "github.com/filecoin-project/go-state-types/builtin/v11/placeholder"
+	power11 "github.com/filecoin-project/go-state-types/builtin/v11/power"
+	reward11 "github.com/filecoin-project/go-state-types/builtin/v11/reward"
+	system11 "github.com/filecoin-project/go-state-types/builtin/v11/system"
+	verifreg11 "github.com/filecoin-project/go-state-types/builtin/v11/verifreg"
+	account8 "github.com/filecoin-project/go-state-types/builtin/v8/account"
+	cron8 "github.com/filecoin-project/go-state-types/builtin/v8/cron"
+	init8 "github.com/filecoin-project/go-state-types/builtin/v8/init"
+	market8 "github.com/filecoin-project/go-state-types/builtin/v8/market"
+	miner8 "github.com/filecoin-project/go-state-types/builtin/v8/miner"
+	multisig8 "github.com/filecoin-project/go-state-types/builtin/v8/multisig"
+	paych8 "github.com/filecoin-project/go-state-types/builtin/v8/paych"
+	power8 "github.com/filecoin-project/go-state-types/builtin/v8/power"
+	reward8 "github.com/filecoin-project/go-state-types/builtin/v8/reward"
+	system8 "github.com/filecoin-project/go-state-types/builtin/v8/system"
+	verifreg8 "github.com/filecoin-project/go-state-types/builtin/v8/verifreg"
+	account9 "github.com/filecoin-project/go-state-types/builtin/v9/account"
+	cron9 "github.com/filecoin-project/go-state-types/builtin/v9/cron"
+	datacap9 "github.com/filecoin-project/go-state-types/builtin/v9/datacap"
+	init9 "github.com/filecoin-project/go-state-types/builtin/v9/init"
+	market9 "github.com/filecoin-project/go-state-types/builtin/v9/market"
+	miner9 "github.com/filecoin-project/go-state-types/builtin/v9/miner"
+	multisig9 "github.com/filecoin-project/go-state-types/builtin/v9/multisig"
+	paych9 "github.com/filecoin-project/go-state-types/builtin/v9/paych"
+	power9 "github.com/filecoin-project/go-state-types/builtin/v9/power"
+	reward9 "github.com/filecoin-project/go-state-types/builtin/v9/reward"
+	system9 "github.com/filecoin-project/go-state-types/builtin/v9/system"
+	verifreg9 "github.com/filecoin-project/go-state-types/builtin/v9/verifreg"
+	"github.com/filecoin-project/go-state-types/proof"
+	"github.com/ipfs/go-cid"
+)
+
+type ReflectableActor struct {
+	State   interface{}
+	Methods map[abi.MethodNum]builtin.MethodMeta
+}
+
+type ReflectableActorMap = map[ActorName]ReflectableActor
+
+// Actors of each actors version, with their state type and methods
+var ReflectableActors = map[ActorsVersion]ReflectableActorMap{
+	actorstypes.Version8: {
+		"account": {
+			State:   (*account8.State)(nil),
+			Methods: account8.Methods,
+		},
+		"cron": {
+			State:   (*cron8.State)(nil),
+			Methods: cron8.Methods,
+		},
+		"init": {
+			State:   (*init8.State)(nil),
+			Methods: init8.Methods,
+		},
+		"multisig": {
+			State:   (*multisig8.State)(nil),
+			Methods: multisig8.Methods,
+		},
+		"paymentchannel": {
+			State:   (*paych8.State)(nil),
+			Methods: paych8.Methods,
+		},
+		"reward": {
+			State:   (*reward8.State)(nil),
+			Methods: reward8.Methods,
+		},
+		"storagemarket": {
+			State:   (*market8.State)(nil),
+			Methods: market8.Methods,
+		},
+		"storageminer": {
+			State:   (*miner8.State)(nil),
+			Methods: miner8.Methods,
+		},
+		"storagepower": {
+			State:   (*power8.State)(nil),
+			Methods: power8.Methods,
+		},
+		"system": {
+			State:   (*system8.State)(nil),
+			Methods: system8.Methods,
+		},
+		"verifiedregistry": {
+			State:   (*verifreg8.State)(nil),
+			Methods: verifreg8.Methods,
+		},
+	},
+	actorstypes.Version9: {
+		"account": {
+			State:   (*account9.State)(nil),
+			Methods: account9.Methods,
+		},
+		"cron": {
+			State:   (*cron9.State)(nil),
+			Methods: cron9.Methods,
+		},
+		"datacap": {
+			State:   (*datacap9.State)(nil),
+			Methods: datacap9.Methods,
+		},
+		"init": {
+			State:   (*init9.State)(nil),
+			Methods: init9.Methods,
+		},
+		"multisig": {
+			State:   (*multisig9.State)(nil),
+			Methods: multisig9.Methods,
+		},
+		"paymentchannel": {
+			State:   (*paych9.State)(nil),
+			Methods: paych9.Methods,
+		},
+		"reward": {
+			State:   (*reward9.State)(nil),
+			Methods: reward9.Methods,
+		},
+		"storagemarket": {
+			State:   (*market9.State)(nil),
+			Methods: market9.Methods,
+		},
+		"storageminer": {
+			State:   (*miner9.State)(nil),
+			Methods: miner9.Methods,
+		},
+		"storagepower": {
+			State:   (*power9.State)(nil),
+			Methods: power9.Methods,
+		},
+		"system": {
+			State:   (*system9.State)(nil),
+			Methods: system9.Methods,
+		},
+		"verifiedregistry": {
+			State:   (*verifreg9.State)(nil),
+			Methods: verifreg9.Methods,
+		},
+	},
+	actorstypes.Version10: {
+		"account": {
+			State:   (*account10.State)(nil),
+			Methods: account10.Methods,
+		},
+		"cron": {
+			State:   (*cron10.State)(nil),
+			Methods: cron10.Methods,
+		},
+		"datacap": {
+			State:   (*datacap10.State)(nil),
+			Methods: datacap10.Methods,
+		},
+		"eam": {
+			State:   nil,
+			Methods: eam10.Methods,
+		},
+		"ethaccount": {
+			State:   nil,
+			Methods: ethaccount10.Methods,
+		},
+		"evm": {
+			State:   (*evm10.State)(nil),
+			Methods: evm10.Methods,
+		},
+		"init": {
+			State:   (*init10.State)(nil),
+			Methods: init10.Methods,
+		},
+		"multisig": {
+			State:   (*multisig10.State)(nil),
+			Methods: multisig10.Methods,
+		},
+		"paymentchannel": {
+			State:   (*paych10.State)(nil),
+			Methods: paych10.Methods,
+		},
+		"placeholder": {
+			State:   nil,
+			Methods: placeholder10.Methods,
+		},
+		"reward": {
+			State:   (*reward10.State)(nil),
+			Methods: reward10.Methods,
+		},
+		"storagemarket": {
+			State:   (*market10.State)(nil),
+			Methods: market10.Methods,
+		},
+		"storageminer": {
+			State:   (*miner10.State)(nil),
+			Methods: miner10.Methods,
+		},
+		"storagepower": {
+			State:   (*power10.State)(nil),
+			Methods: power10.Methods,
+		},
+		"system": {
+			State:   (*system10.State)(nil),
+			Methods: system10.Methods,
+		},
+		"verifiedregistry": {
+			State:   (*verifreg10.State)(nil),
+			Methods: verifreg10.Methods,
+		},
+	},
+	actorstypes.Version11: {
+		"account": {
+			State:   (*account11.State)(nil),
+			Methods: account11.Methods,
+		},
+		"cron": {
+			State:   (*cron11.State)(nil),
+			Methods: cron11.Methods,
+		},
+		"datacap": {
+			State:   (*datacap11.State)(nil),
+			Methods: datacap11.Methods,
+		},
+		"eam": {
+			State:   nil,
+			Methods: eam11.Methods,
+		},
+		"ethaccount": {
+			State:   nil,
+			Methods: ethaccount11.Methods,
+		},
+		"evm": {
+			State:   (*evm11.State)(nil),
+			Methods: evm11.Methods,
+		},
+		"init": {
+			State:   (*init11.State)(nil),
+			Methods: init11.Methods,
+		},
+		"multisig": {
+			State:   (*multisig11.State)(nil),
+			Methods: multisig11.Methods,
+		},
+		"paymentchannel": {
+			State:   (*paych11.State)(nil),
+			Methods: paych11.Methods,
+		},
+		"placeholder": {
+			State:   nil,
+			Methods: placeholder11.Methods,
+		},
+		"reward": {
+			State:   (*reward11.State)(nil),
+			Methods: reward11.Methods,
+		},
+		"storagemarket": {
+			State:   (*market11.State)(nil),
+			Methods: market11.Methods,
+		},
+		"storageminer": {
+			State:   (*miner11.State)(nil),
+			Methods: miner11.Methods,
+		},
+		"storagepower": {
+			State:   (*power11.State)(nil),
+			Methods: power11.Methods,
+		},
+		"system": {
+			State:   (*system11.State)(nil),
+			Methods: system11.Methods,
+		},
+		"verifiedregistry": {
+			State:   (*verifreg11.State)(nil),
+			Methods: verifreg11.Methods,
+		},
+	},
+}
+
+// Collection roots of state fields by type ID and field name
+var containerFields = map[TypeId]containerField{
+	"github.com/filecoin-project/go-state-types/builtin/v8/init.State.AddressMap": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/multisig.State.PendingTxns": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyVarint,
+		Key:         reflect.TypeOf((*multisig8.TxnID)(nil)).Elem(),
+		Value:       reflect.TypeOf((*multisig8.Transaction)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/paych.State.LaneStates": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 3,
+		Value:    reflect.TypeOf((*paych8.LaneState)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.State.Proposals": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 5,
+		Value:    reflect.TypeOf((*market8.DealProposal)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.State.States": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 6,
+		Value:    reflect.TypeOf((*market8.DealState)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.State.PendingProposals": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyCid,
+		Key:         reflect.TypeOf((*cid.Cid)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.State.EscrowTable": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.State.LockedTable": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.State.DealOpsByEpoch": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ChainEpoch)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    5,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*abi.DealID)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.State.PreCommittedSectors": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.SectorNumber)(nil)).Elem(),
+		Value:       reflect.TypeOf((*miner8.SectorPreCommitOnChainInfo)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.State.PreCommittedSectorsCleanUp": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 6,
+		Value:    reflect.TypeOf((*bitfield.BitField)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.State.Sectors": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 5,
+		Value:    reflect.TypeOf((*miner8.SectorOnChainInfo)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.CronEventQueue": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    6,
+		KeyEncoding: KeyVarint,
+		Key:         reflect.TypeOf((*abi.ChainEpoch)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:     ContainerAmt,
+			BitWidth: 6,
+			Value:    reflect.TypeOf((*power8.CronEvent)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.Claims": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*power8.Claim)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.ProofValidationBatch": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:     ContainerAmt,
+			BitWidth: 4,
+			Value:    reflect.TypeOf((*proof.SealVerifyInfo)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/verifreg.State.Verifiers": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*verifreg8.DataCap)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/verifreg.State.VerifiedClients": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*verifreg8.DataCap)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v8/verifreg.State.RemoveDataCapProposalIDs": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyCbor,
+		Key:         reflect.TypeOf((*abi.AddrPairKey)(nil)).Elem(),
+		Value:       reflect.TypeOf((*verifreg8.RmDcProposalID)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.TokenState.Balances": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    3,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.TokenState.Allowances": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    3,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    3,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+			Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/init.State.AddressMap": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/multisig.State.PendingTxns": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyVarint,
+		Key:         reflect.TypeOf((*multisig9.TxnID)(nil)).Elem(),
+		Value:       reflect.TypeOf((*multisig9.Transaction)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/paych.State.LaneStates": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 3,
+		Value:    reflect.TypeOf((*paych9.LaneState)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.State.Proposals": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 5,
+		Value:    reflect.TypeOf((*market9.DealProposal)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.State.States": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 6,
+		Value:    reflect.TypeOf((*market9.DealState)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.State.PendingProposals": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyCid,
+		Key:         reflect.TypeOf((*cid.Cid)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.State.EscrowTable": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.State.LockedTable": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.State.DealOpsByEpoch": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ChainEpoch)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    5,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*abi.DealID)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.State.PendingDealAllocationIds": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.DealID)(nil)).Elem(),
+		Value:       reflect.TypeOf((*int64)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.State.PreCommittedSectors": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.SectorNumber)(nil)).Elem(),
+		Value:       reflect.TypeOf((*miner9.SectorPreCommitOnChainInfo)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.State.PreCommittedSectorsCleanUp": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 6,
+		Value:    reflect.TypeOf((*bitfield.BitField)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.State.Sectors": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 5,
+		Value:    reflect.TypeOf((*miner9.SectorOnChainInfo)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.CronEventQueue": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    6,
+		KeyEncoding: KeyVarint,
+		Key:         reflect.TypeOf((*abi.ChainEpoch)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:     ContainerAmt,
+			BitWidth: 6,
+			Value:    reflect.TypeOf((*power9.CronEvent)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.Claims": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*power9.Claim)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.ProofValidationBatch": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:     ContainerAmt,
+			BitWidth: 4,
+			Value:    reflect.TypeOf((*proof.SealVerifyInfo)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.State.Verifiers": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*verifreg9.DataCap)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.State.RemoveDataCapProposalIDs": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyCbor,
+		Key:         reflect.TypeOf((*abi.AddrPairKey)(nil)).Elem(),
+		Value:       reflect.TypeOf((*verifreg9.RmDcProposalID)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.State.Allocations": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    5,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*verifreg9.AllocationId)(nil)).Elem(),
+			Value:       reflect.TypeOf((*verifreg9.Allocation)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.State.Claims": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    5,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*verifreg9.ClaimId)(nil)).Elem(),
+			Value:       reflect.TypeOf((*verifreg9.Claim)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.TokenState.Balances": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    3,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.TokenState.Allowances": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    3,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    3,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+			Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/evm.State.ContractState": containerField{
+		Kind:        ContainerKamt,
+		BitWidth:    5,
+		KeyEncoding: KeyBytes,
+		Key:         reflect.TypeOf((*[]byte)(nil)).Elem(),
+		Value:       reflect.TypeOf((*[]byte)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/init.State.AddressMap": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/multisig.State.PendingTxns": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyVarint,
+		Key:         reflect.TypeOf((*multisig10.TxnID)(nil)).Elem(),
+		Value:       reflect.TypeOf((*multisig10.Transaction)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/paych.State.LaneStates": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 3,
+		Value:    reflect.TypeOf((*paych10.LaneState)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.State.Proposals": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 5,
+		Value:    reflect.TypeOf((*market10.DealProposal)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.State.States": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 6,
+		Value:    reflect.TypeOf((*market10.DealState)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.State.PendingProposals": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyCid,
+		Key:         reflect.TypeOf((*cid.Cid)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.State.EscrowTable": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.State.LockedTable": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.State.DealOpsByEpoch": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ChainEpoch)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    5,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*abi.DealID)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.State.PendingDealAllocationIds": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.DealID)(nil)).Elem(),
+		Value:       reflect.TypeOf((*int64)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.State.PreCommittedSectors": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.SectorNumber)(nil)).Elem(),
+		Value:       reflect.TypeOf((*miner10.SectorPreCommitOnChainInfo)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.State.PreCommittedSectorsCleanUp": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 6,
+		Value:    reflect.TypeOf((*bitfield.BitField)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.State.Sectors": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 5,
+		Value:    reflect.TypeOf((*miner10.SectorOnChainInfo)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.CronEventQueue": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    6,
+		KeyEncoding: KeyVarint,
+		Key:         reflect.TypeOf((*abi.ChainEpoch)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:     ContainerAmt,
+			BitWidth: 6,
+			Value:    reflect.TypeOf((*power10.CronEvent)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.Claims": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*power10.Claim)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.ProofValidationBatch": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:     ContainerAmt,
+			BitWidth: 4,
+			Value:    reflect.TypeOf((*proof.SealVerifyInfo)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.State.Verifiers": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*verifreg10.DataCap)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.State.RemoveDataCapProposalIDs": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyCbor,
+		Key:         reflect.TypeOf((*abi.AddrPairKey)(nil)).Elem(),
+		Value:       reflect.TypeOf((*verifreg10.RmDcProposalID)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.State.Allocations": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    5,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*verifreg10.AllocationId)(nil)).Elem(),
+			Value:       reflect.TypeOf((*verifreg10.Allocation)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.State.Claims": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    5,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*verifreg10.ClaimId)(nil)).Elem(),
+			Value:       reflect.TypeOf((*verifreg10.Claim)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.TokenState.Balances": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    3,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.TokenState.Allowances": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    3,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    3,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+			Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/evm.State.ContractState": containerField{
+		Kind:        ContainerKamt,
+		BitWidth:    5,
+		KeyEncoding: KeyBytes,
+		Key:         reflect.TypeOf((*[]byte)(nil)).Elem(),
+		Value:       reflect.TypeOf((*[]byte)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/init.State.AddressMap": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/multisig.State.PendingTxns": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyVarint,
+		Key:         reflect.TypeOf((*multisig11.TxnID)(nil)).Elem(),
+		Value:       reflect.TypeOf((*multisig11.Transaction)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/paych.State.LaneStates": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 3,
+		Value:    reflect.TypeOf((*paych11.LaneState)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.State.Proposals": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 5,
+		Value:    reflect.TypeOf((*market11.DealProposal)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.State.States": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 6,
+		Value:    reflect.TypeOf((*market11.DealState)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.State.PendingProposals": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyCid,
+		Key:         reflect.TypeOf((*cid.Cid)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.State.EscrowTable": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.State.LockedTable": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*abi.TokenAmount)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.State.DealOpsByEpoch": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ChainEpoch)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    5,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*abi.DealID)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.State.PendingDealAllocationIds": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.DealID)(nil)).Elem(),
+		Value:       reflect.TypeOf((*int64)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.State.PreCommittedSectors": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.SectorNumber)(nil)).Elem(),
+		Value:       reflect.TypeOf((*miner11.SectorPreCommitOnChainInfo)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.State.PreCommittedSectorsCleanUp": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 6,
+		Value:    reflect.TypeOf((*bitfield.BitField)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.State.Sectors": containerField{
+		Kind:     ContainerAmt,
+		BitWidth: 5,
+		Value:    reflect.TypeOf((*miner11.SectorOnChainInfo)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.CronEventQueue": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    6,
+		KeyEncoding: KeyVarint,
+		Key:         reflect.TypeOf((*abi.ChainEpoch)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:     ContainerAmt,
+			BitWidth: 6,
+			Value:    reflect.TypeOf((*power11.CronEvent)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.Claims": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*power11.Claim)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.ProofValidationBatch": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:     ContainerAmt,
+			BitWidth: 4,
+			Value:    reflect.TypeOf((*proof.SealVerifyInfo)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.State.Verifiers": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Key:         reflect.TypeOf((*address.Address)(nil)).Elem(),
+		Value:       reflect.TypeOf((*verifreg11.DataCap)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.State.RemoveDataCapProposalIDs": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyCbor,
+		Key:         reflect.TypeOf((*abi.AddrPairKey)(nil)).Elem(),
+		Value:       reflect.TypeOf((*verifreg11.RmDcProposalID)(nil)).Elem(),
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.State.Allocations": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    5,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*verifreg11.AllocationId)(nil)).Elem(),
+			Value:       reflect.TypeOf((*verifreg11.Allocation)(nil)).Elem(),
+		},
+	},
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.State.Claims": containerField{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyUvarint,
+		Key:         reflect.TypeOf((*abi.ActorID)(nil)).Elem(),
+		Nested: &containerField{
+			Kind:        ContainerHamt,
+			BitWidth:    5,
+			KeyEncoding: KeyUvarint,
+			Key:         reflect.TypeOf((*verifreg11.ClaimId)(nil)).Elem(),
+			Value:       reflect.TypeOf((*verifreg11.Claim)(nil)).Elem(),
+		},
+	},
+}
+
+// Semantics of struct fields declared with an alias, by type ID and field name
+var semanticFields = map[TypeId]string{
+	"github.com/filecoin-project/go-state-types/builtin/v8/multisig.LockBalanceParams.Amount":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/multisig.ProposalHashData.Value":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/multisig.ProposeParams.Value":                              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/multisig.State.InitialBalance":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/multisig.Transaction.Value":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/paych.State.ToSend":                                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/paych.StateSummary.Redeemed":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/reward.AwardBlockRewardParams.Penalty":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/reward.AwardBlockRewardParams.GasReward":                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/reward.State.EffectiveBaselinePower":                       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/reward.State.ThisEpochReward":                              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/reward.State.ThisEpochBaselinePower":                       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/reward.State.TotalStoragePowerReward":                      SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/reward.State.SimpleTotal":                                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/reward.State.BaselineTotal":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/reward.ThisEpochRewardReturn.ThisEpochBaselinePower":       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.DealProposal.StoragePricePerEpoch":                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.DealProposal.ProviderCollateral":                    SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.DealProposal.ClientCollateral":                      SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.SectorWeights.DealWeight":                           SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.SectorWeights.VerifiedDealWeight":                   SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.State.TotalClientLockedCollateral":                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.State.TotalProviderLockedCollateral":                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.State.TotalClientStorageFee":                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.WithdrawBalanceParams.Amount":                       SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.ApplyRewardParams.Reward":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.ApplyRewardParams.Penalty":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.ChangeMultiaddrsParams.NewMultiaddrs":                SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.ChangePeerIDParams.NewID":                            SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.ConfirmSectorProofsParams.RewardBaselinePower":       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.ExpirationQueueStateSummary.OnTimePledge":            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.ExpirationSet.OnTimePledge":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.MinerInfo.PeerId":                                    SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.MinerInfo.Multiaddrs":                                SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.PowerPair.Raw":                                       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.PowerPair.QA":                                        SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.SectorOnChainInfo.DealWeight":                        SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.SectorOnChainInfo.VerifiedDealWeight":                SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.SectorOnChainInfo.InitialPledge":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.SectorOnChainInfo.ExpectedDayReward":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.SectorOnChainInfo.ExpectedStoragePledge":             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.SectorOnChainInfo.ReplacedDayReward":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.SectorPreCommitOnChainInfo.PreCommitDeposit":         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.SectorPreCommitOnChainInfo.DealWeight":               SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.SectorPreCommitOnChainInfo.VerifiedDealWeight":       SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.State.PreCommitDeposits":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.State.LockedFunds":                                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.State.FeeDebt":                                       SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.State.InitialPledge":                                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.VestingFund.Amount":                                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/miner.WithdrawBalanceParams.AmountRequested":               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.Claim.RawBytePower":                                  SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.Claim.QualityAdjPower":                               SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.CreateMinerParams.Peer":                              SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.CreateMinerParams.Multiaddrs":                        SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.CurrentTotalPowerReturn.RawBytePower":                SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.CurrentTotalPowerReturn.QualityAdjPower":             SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.CurrentTotalPowerReturn.PledgeCollateral":            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.MinerConstructorParams.PeerId":                       SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.MinerConstructorParams.Multiaddrs":                   SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.TotalRawBytePower":                             SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.TotalBytesCommitted":                           SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.TotalQualityAdjPower":                          SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.TotalQABytesCommitted":                         SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.TotalPledgeCollateral":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.ThisEpochRawBytePower":                         SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.ThisEpochQualityAdjPower":                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.State.ThisEpochPledgeCollateral":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.UpdateClaimedPowerParams.RawByteDelta":               SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/power.UpdateClaimedPowerParams.QualityAdjustedDelta":       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/verifreg.AddVerifiedClientParams.Allowance":                SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/verifreg.AddVerifierParams.Allowance":                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/verifreg.RemoveDataCapParams.DataCapAmountToRemove":        SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/verifreg.RemoveDataCapProposal.DataCapAmount":              SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/verifreg.RemoveDataCapReturn.DataCapRemoved":               SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/verifreg.RestoreBytesParams.DealSize":                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v8/verifreg.UseBytesParams.DealSize":                          SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.BurnFromParams.Amount":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.BurnFromReturn.Balance":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.BurnFromReturn.Allowance":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.BurnParams.Amount":                                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.BurnReturn.Balance":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.DecreaseAllowanceParams.Decrease":                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.DestroyParams.Amount":                              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.IncreaseAllowanceParams.Increase":                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.MintParams.Amount":                                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.MintReturn.Balance":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.MintReturn.Supply":                                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.StateSummary.TotalSupply":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.TokenState.Supply":                                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.TransferFromParams.Amount":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.TransferFromReturn.FromBalance":                    SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.TransferFromReturn.ToBalance":                      SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.TransferFromReturn.Allowance":                      SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.TransferParams.Amount":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.TransferReturn.FromBalance":                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/datacap.TransferReturn.ToBalance":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/multisig.LockBalanceParams.Amount":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/multisig.ProposalHashData.Value":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/multisig.ProposeParams.Value":                              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/multisig.State.InitialBalance":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/multisig.Transaction.Value":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/paych.State.ToSend":                                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/paych.StateSummary.Redeemed":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/reward.AwardBlockRewardParams.Penalty":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/reward.AwardBlockRewardParams.GasReward":                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/reward.State.EffectiveBaselinePower":                       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/reward.State.ThisEpochReward":                              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/reward.State.ThisEpochBaselinePower":                       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/reward.State.TotalStoragePowerReward":                      SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/reward.State.SimpleTotal":                                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/reward.State.BaselineTotal":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/reward.ThisEpochRewardReturn.ThisEpochBaselinePower":       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.DealProposal.StoragePricePerEpoch":                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.DealProposal.ProviderCollateral":                    SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.DealProposal.ClientCollateral":                      SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.DealSpaces.DealSpace":                               SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.DealSpaces.VerifiedDealSpace":                       SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.State.TotalClientLockedCollateral":                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.State.TotalProviderLockedCollateral":                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.State.TotalClientStorageFee":                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.WithdrawBalanceParams.Amount":                       SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.ApplyRewardParams.Reward":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.ApplyRewardParams.Penalty":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.BeneficiaryTerm.Quota":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.BeneficiaryTerm.UsedQuota":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.ChangeBeneficiaryParams.NewQuota":                    SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.ChangeMultiaddrsParams.NewMultiaddrs":                SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.ChangePeerIDParams.NewID":                            SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.ConfirmSectorProofsParams.RewardBaselinePower":       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.ExpirationQueueStateSummary.OnTimePledge":            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.ExpirationSet.OnTimePledge":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.MinerInfo.PeerId":                                    SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.MinerInfo.Multiaddrs":                                SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.PendingBeneficiaryChange.NewQuota":                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.PowerPair.Raw":                                       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.PowerPair.QA":                                        SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.SectorOnChainInfo.DealWeight":                        SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.SectorOnChainInfo.VerifiedDealWeight":                SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.SectorOnChainInfo.InitialPledge":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.SectorOnChainInfo.ExpectedDayReward":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.SectorOnChainInfo.ExpectedStoragePledge":             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.SectorOnChainInfo.ReplacedDayReward":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.SectorPreCommitOnChainInfo.PreCommitDeposit":         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.State.PreCommitDeposits":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.State.LockedFunds":                                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.State.FeeDebt":                                       SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.State.InitialPledge":                                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.VestingFund.Amount":                                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/miner.WithdrawBalanceParams.AmountRequested":               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.Claim.RawBytePower":                                  SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.Claim.QualityAdjPower":                               SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.CreateMinerParams.Peer":                              SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.CreateMinerParams.Multiaddrs":                        SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.CurrentTotalPowerReturn.RawBytePower":                SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.CurrentTotalPowerReturn.QualityAdjPower":             SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.CurrentTotalPowerReturn.PledgeCollateral":            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.MinerConstructorParams.PeerId":                       SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.MinerConstructorParams.Multiaddrs":                   SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.TotalRawBytePower":                             SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.TotalBytesCommitted":                           SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.TotalQualityAdjPower":                          SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.TotalQABytesCommitted":                         SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.TotalPledgeCollateral":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.ThisEpochRawBytePower":                         SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.ThisEpochQualityAdjPower":                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.State.ThisEpochPledgeCollateral":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.UpdateClaimedPowerParams.RawByteDelta":               SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/power.UpdateClaimedPowerParams.QualityAdjustedDelta":       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.AddVerifiedClientParams.Allowance":                SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.AddVerifierParams.Allowance":                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.RemoveDataCapParams.DataCapAmountToRemove":        SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.RemoveDataCapProposal.DataCapAmount":              SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.RemoveDataCapReturn.DataCapRemoved":               SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.RemoveExpiredAllocationsReturn.DataCapRecovered":  SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.RestoreBytesParams.DealSize":                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v9/verifreg.UseBytesParams.DealSize":                          SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.BurnFromParams.Amount":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.BurnFromReturn.Balance":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.BurnFromReturn.Allowance":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.BurnParams.Amount":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.BurnReturn.Balance":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.DecreaseAllowanceParams.Decrease":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.DestroyParams.Amount":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.IncreaseAllowanceParams.Increase":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.MintParams.Amount":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.MintReturn.Balance":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.MintReturn.Supply":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.StateSummary.TotalSupply":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.TokenState.Supply":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.TransferFromParams.Amount":                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.TransferFromReturn.FromBalance":                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.TransferFromReturn.ToBalance":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.TransferFromReturn.Allowance":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.TransferParams.Amount":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.TransferReturn.FromBalance":                       SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/datacap.TransferReturn.ToBalance":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/evm.DelegateCallParams.Value":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/multisig.LockBalanceParams.Amount":                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/multisig.ProposalHashData.Value":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/multisig.ProposeParams.Value":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/multisig.State.InitialBalance":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/multisig.Transaction.Value":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/paych.State.ToSend":                                       SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/paych.StateSummary.Redeemed":                              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/reward.AwardBlockRewardParams.Penalty":                    SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/reward.AwardBlockRewardParams.GasReward":                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/reward.State.EffectiveBaselinePower":                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/reward.State.ThisEpochReward":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/reward.State.ThisEpochBaselinePower":                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/reward.State.TotalStoragePowerReward":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/reward.State.SimpleTotal":                                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/reward.State.BaselineTotal":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/reward.ThisEpochRewardReturn.ThisEpochBaselinePower":      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.DealProposal.StoragePricePerEpoch":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.DealProposal.ProviderCollateral":                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.DealProposal.ClientCollateral":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.DealSpaces.DealSpace":                              SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.DealSpaces.VerifiedDealSpace":                      SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.GetBalanceReturn.Balance":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.GetBalanceReturn.Locked":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.State.TotalClientLockedCollateral":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.State.TotalProviderLockedCollateral":               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.State.TotalClientStorageFee":                       SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.WithdrawBalanceParams.Amount":                      SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.ApplyRewardParams.Reward":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.ApplyRewardParams.Penalty":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.BeneficiaryTerm.Quota":                              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.BeneficiaryTerm.UsedQuota":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.ChangeBeneficiaryParams.NewQuota":                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.ChangeMultiaddrsParams.NewMultiaddrs":               SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.ChangePeerIDParams.NewID":                           SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.ConfirmSectorProofsParams.RewardBaselinePower":      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.ExpirationQueueStateSummary.OnTimePledge":           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.ExpirationSet.OnTimePledge":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.MinerInfo.PeerId":                                   SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.MinerInfo.Multiaddrs":                               SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.PendingBeneficiaryChange.NewQuota":                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.PowerPair.Raw":                                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.PowerPair.QA":                                       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.SectorOnChainInfo.DealWeight":                       SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.SectorOnChainInfo.VerifiedDealWeight":               SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.SectorOnChainInfo.InitialPledge":                    SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.SectorOnChainInfo.ExpectedDayReward":                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.SectorOnChainInfo.ExpectedStoragePledge":            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.SectorOnChainInfo.ReplacedDayReward":                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.SectorPreCommitOnChainInfo.PreCommitDeposit":        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.State.PreCommitDeposits":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.State.LockedFunds":                                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.State.FeeDebt":                                      SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.State.InitialPledge":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.VestingFund.Amount":                                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.WithdrawBalanceParams.AmountRequested":              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/miner.GetPeerIDReturn.PeerId":                             SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.Claim.RawBytePower":                                 SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.Claim.QualityAdjPower":                              SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.CreateMinerParams.Peer":                             SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.CreateMinerParams.Multiaddrs":                       SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.CurrentTotalPowerReturn.RawBytePower":               SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.CurrentTotalPowerReturn.QualityAdjPower":            SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.CurrentTotalPowerReturn.PledgeCollateral":           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.MinerConstructorParams.PeerId":                      SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.MinerConstructorParams.Multiaddrs":                  SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.MinerRawPowerReturn.RawBytePower":                   SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.TotalRawBytePower":                            SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.TotalBytesCommitted":                          SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.TotalQualityAdjPower":                         SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.TotalQABytesCommitted":                        SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.TotalPledgeCollateral":                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.ThisEpochRawBytePower":                        SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.ThisEpochQualityAdjPower":                     SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.State.ThisEpochPledgeCollateral":                    SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.UpdateClaimedPowerParams.RawByteDelta":              SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/power.UpdateClaimedPowerParams.QualityAdjustedDelta":      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.AddVerifiedClientParams.Allowance":               SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.AddVerifierParams.Allowance":                     SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.RemoveDataCapParams.DataCapAmountToRemove":       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.RemoveDataCapProposal.DataCapAmount":             SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.RemoveDataCapReturn.DataCapRemoved":              SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.RemoveExpiredAllocationsReturn.DataCapRecovered": SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.RestoreBytesParams.DealSize":                     SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v10/verifreg.UseBytesParams.DealSize":                         SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.BurnFromParams.Amount":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.BurnFromReturn.Balance":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.BurnFromReturn.Allowance":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.BurnParams.Amount":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.BurnReturn.Balance":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.DecreaseAllowanceParams.Decrease":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.DestroyParams.Amount":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.IncreaseAllowanceParams.Increase":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.MintParams.Amount":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.MintReturn.Balance":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.MintReturn.Supply":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.StateSummary.TotalSupply":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.TokenState.Supply":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.TransferFromParams.Amount":                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.TransferFromReturn.FromBalance":                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.TransferFromReturn.ToBalance":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.TransferFromReturn.Allowance":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.TransferParams.Amount":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.TransferReturn.FromBalance":                       SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/datacap.TransferReturn.ToBalance":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/evm.DelegateCallParams.Value":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/multisig.LockBalanceParams.Amount":                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/multisig.ProposalHashData.Value":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/multisig.ProposeParams.Value":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/multisig.State.InitialBalance":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/multisig.Transaction.Value":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/paych.State.ToSend":                                       SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/paych.StateSummary.Redeemed":                              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/reward.AwardBlockRewardParams.Penalty":                    SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/reward.AwardBlockRewardParams.GasReward":                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/reward.State.EffectiveBaselinePower":                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/reward.State.ThisEpochReward":                             SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/reward.State.ThisEpochBaselinePower":                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/reward.State.TotalStoragePowerReward":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/reward.State.SimpleTotal":                                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/reward.State.BaselineTotal":                               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/reward.ThisEpochRewardReturn.ThisEpochBaselinePower":      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.DealProposal.StoragePricePerEpoch":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.DealProposal.ProviderCollateral":                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.DealProposal.ClientCollateral":                     SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.DealSpaces.DealSpace":                              SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.DealSpaces.VerifiedDealSpace":                      SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.GetBalanceReturn.Balance":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.GetBalanceReturn.Locked":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.State.TotalClientLockedCollateral":                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.State.TotalProviderLockedCollateral":               SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.State.TotalClientStorageFee":                       SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.WithdrawBalanceParams.Amount":                      SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.ApplyRewardParams.Reward":                           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.ApplyRewardParams.Penalty":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.BeneficiaryTerm.Quota":                              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.BeneficiaryTerm.UsedQuota":                          SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.ChangeBeneficiaryParams.NewQuota":                   SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.ChangeMultiaddrsParams.NewMultiaddrs":               SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.ChangePeerIDParams.NewID":                           SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.ConfirmSectorProofsParams.RewardBaselinePower":      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.ExpirationQueueStateSummary.OnTimePledge":           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.ExpirationSet.OnTimePledge":                         SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.MinerInfo.PeerId":                                   SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.MinerInfo.Multiaddrs":                               SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.PendingBeneficiaryChange.NewQuota":                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.PowerPair.Raw":                                      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.PowerPair.QA":                                       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.SectorOnChainInfo.DealWeight":                       SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.SectorOnChainInfo.VerifiedDealWeight":               SemanticDealWeight,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.SectorOnChainInfo.InitialPledge":                    SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.SectorOnChainInfo.ExpectedDayReward":                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.SectorOnChainInfo.ExpectedStoragePledge":            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.SectorOnChainInfo.ReplacedDayReward":                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.SectorPreCommitOnChainInfo.PreCommitDeposit":        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.State.PreCommitDeposits":                            SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.State.LockedFunds":                                  SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.State.FeeDebt":                                      SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.State.InitialPledge":                                SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.VestingFund.Amount":                                 SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.WithdrawBalanceParams.AmountRequested":              SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner.GetPeerIDReturn.PeerId":                             SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.Claim.RawBytePower":                                 SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.Claim.QualityAdjPower":                              SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.CreateMinerParams.Peer":                             SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.CreateMinerParams.Multiaddrs":                       SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.CurrentTotalPowerReturn.RawBytePower":               SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.CurrentTotalPowerReturn.QualityAdjPower":            SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.CurrentTotalPowerReturn.PledgeCollateral":           SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.MinerConstructorParams.PeerId":                      SemanticPeerID,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.MinerConstructorParams.Multiaddrs":                  SemanticMultiaddr,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.MinerRawPowerReturn.RawBytePower":                   SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.TotalRawBytePower":                            SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.TotalBytesCommitted":                          SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.TotalQualityAdjPower":                         SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.TotalQABytesCommitted":                        SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.TotalPledgeCollateral":                        SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.ThisEpochRawBytePower":                        SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.ThisEpochQualityAdjPower":                     SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.State.ThisEpochPledgeCollateral":                    SemanticTokenAmount,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.UpdateClaimedPowerParams.RawByteDelta":              SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/power.UpdateClaimedPowerParams.QualityAdjustedDelta":      SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.AddVerifiedClientParams.Allowance":               SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.AddVerifierParams.Allowance":                     SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.RemoveDataCapParams.DataCapAmountToRemove":       SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.RemoveDataCapProposal.DataCapAmount":             SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.RemoveDataCapReturn.DataCapRemoved":              SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.RemoveExpiredAllocationsReturn.DataCapRecovered": SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.RestoreBytesParams.DealSize":                     SemanticStoragePower,
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg.UseBytesParams.DealSize":                         SemanticStoragePower,
+}
+
+// Semantics of actor package types with custom marshalling by type ID
+var semanticTypes = map[TypeId]string{
+	"github.com/filecoin-project/go-state-types/builtin/v8/market.DealLabel":       SemanticDealLabel,
+	"github.com/filecoin-project/go-state-types/builtin/v9/market.DealLabel":       SemanticDealLabel,
+	"github.com/filecoin-project/go-state-types/builtin/v10/evm.GetBytecodeReturn": SemanticBytecodeReturn,
+	"github.com/filecoin-project/go-state-types/builtin/v10/market.DealLabel":      SemanticDealLabel,
+	"github.com/filecoin-project/go-state-types/builtin/v11/evm.GetBytecodeReturn": SemanticBytecodeReturn,
+	"github.com/filecoin-project/go-state-types/builtin/v11/market.DealLabel":      SemanticDealLabel,
+}
+
+// Named values of enum-like integer types by type ID
+var enumTypes = map[TypeId]enumType{
+	"github.com/filecoin-project/go-state-types/abi.RegisteredSealProof": {
+		Open: false,
+		Values: []EnumValue{
+			{Name: "StackedDrg2KiBV1", Value: 0},
+			{Name: "StackedDrg8MiBV1", Value: 1},
+			{Name: "StackedDrg512MiBV1", Value: 2},
+			{Name: "StackedDrg32GiBV1", Value: 3},
+			{Name: "StackedDrg64GiBV1", Value: 4},
+			{Name: "StackedDrg2KiBV1_1", Value: 5},
+			{Name: "StackedDrg8MiBV1_1", Value: 6},
+			{Name: "StackedDrg512MiBV1_1", Value: 7},
+			{Name: "StackedDrg32GiBV1_1", Value: 8},
+			{Name: "StackedDrg64GiBV1_1", Value: 9},
+		},
+	},
+	"github.com/filecoin-project/go-state-types/abi.RegisteredPoStProof": {
+		Open: false,
+		Values: []EnumValue{
+			{Name: "StackedDrgWinning2KiBV1", Value: 0},
+			{Name: "StackedDrgWinning8MiBV1", Value: 1},
+			{Name: "StackedDrgWinning512MiBV1", Value: 2},
+			{Name: "StackedDrgWinning32GiBV1", Value: 3},
+			{Name: "StackedDrgWinning64GiBV1", Value: 4},
+			{Name: "StackedDrgWindow2KiBV1", Value: 5},
+			{Name: "StackedDrgWindow8MiBV1", Value: 6},
+			{Name: "StackedDrgWindow512MiBV1", Value: 7},
+			{Name: "StackedDrgWindow32GiBV1", Value: 8},
+			{Name: "StackedDrgWindow64GiBV1", Value: 9},
+		},
+	},
+	"github.com/filecoin-project/go-state-types/abi.RegisteredAggregationProof": {
+		Open: false,
+		Values: []EnumValue{
+			{Name: "SnarkPackV1", Value: 0},
+			{Name: "SnarkPackV2", Value: 1},
+		},
+	},
+	"github.com/filecoin-project/go-state-types/abi.RegisteredUpdateProof": {
+		Open: false,
+		Values: []EnumValue{
+			{Name: "StackedDrg2KiBV1", Value: 0},
+			{Name: "StackedDrg8MiBV1", Value: 1},
+			{Name: "StackedDrg512MiBV1", Value: 2},
+			{Name: "StackedDrg32GiBV1", Value: 3},
+			{Name: "StackedDrg64GiBV1", Value: 4},
+		},
+	},
+	"github.com/filecoin-project/go-state-types/crypto.SigType": {
+		Open: false,
+		Values: []EnumValue{
+			{Name: "Secp256k1", Value: 1},
+			{Name: "BLS", Value: 2},
+			{Name: "Delegated", Value: 3},
+		},
+	},
+	"github.com/filecoin-project/go-state-types/exitcode.ExitCode": {
+		Open: true,
+		Values: []EnumValue{
+			{Name: "Ok", Value: 0},
+			{Name: "SysErrSenderInvalid", Value: 1},
+			{Name: "SysErrSenderStateInvalid", Value: 2},
+			{Name: "SysErrReserved1", Value: 3},
+			{Name: "SysErrIllegalInstruction", Value: 4},
+			{Name: "SysErrInvalidReceiver", Value: 5},
+			{Name: "SysErrInsufficientFunds", Value: 6},
+			{Name: "SysErrOutOfGas", Value: 7},
+			{Name: "SysErrReserved2", Value: 8},
+			{Name: "SysErrIllegalExitCode", Value: 9},
+			{Name: "SysErrFatal", Value: 10},
+			{Name: "SysErrMissingReturn", Value: 11},
+			{Name: "SysErrReserved3", Value: 12},
+			{Name: "SysErrReserved4", Value: 13},
+			{Name: "SysErrReserved5", Value: 14},
+			{Name: "SysErrReserved6", Value: 15},
+			{Name: "ErrIllegalArgument", Value: 16},
+			{Name: "ErrNotFound", Value: 17},
+			{Name: "ErrForbidden", Value: 18},
+			{Name: "ErrInsufficientFunds", Value: 19},
+			{Name: "ErrIllegalState", Value: 20},
+			{Name: "ErrSerialization", Value: 21},
+			{Name: "ErrUnhandledMessage", Value: 22},
+			{Name: "ErrUnspecified", Value: 23},
+			{Name: "ErrAssertionFailed", Value: 24},
+			{Name: "ErrReadOnly", Value: 25},
+		},
+	},
+	"github.com/filecoin-project/go-state-types/network.Version": {
+		Open: true,
+		Values: []EnumValue{
+			{Name: "Version0", Value: 0},
+			{Name: "Version1", Value: 1},
+			{Name: "Version2", Value: 2},
+			{Name: "Version3", Value: 3},
+			{Name: "Version4", Value: 4},
+			{Name: "Version5", Value: 5},
+			{Name: "Version6", Value: 6},
+			{Name: "Version7", Value: 7},
+			{Name: "Version8", Value: 8},
+			{Name: "Version9", Value: 9},
+			{Name: "Version10", Value: 10},
+			{Name: "Version11", Value: 11},
+			{Name: "Version12", Value: 12},
+			{Name: "Version13", Value: 13},
+			{Name: "Version14", Value: 14},
+			{Name: "Version15", Value: 15},
+			{Name: "Version16", Value: 16},
+			{Name: "Version17", Value: 17},
+			{Name: "Version18", Value: 18},
+			{Name: "Version19", Value: 19},
+			{Name: "Version20", Value: 20},
+		},
+	},
+}
+
+// Method numbers exported by the latest actors version
+var builtinMethods = map[ActorName]interface{}{
+	"account":          builtin.MethodsAccount,
+	"cron":             builtin.MethodsCron,
+	"datacap":          builtin.MethodsDatacap,
+	"eam":              builtin.MethodsEAM,
+	"ethaccount":       builtin.MethodsEthAccount,
+	"evm":              builtin.MethodsEVM,
+	"init":             builtin.MethodsInit,
+	"multisig":         builtin.MethodsMultisig,
+	"paymentchannel":   builtin.MethodsPaych,
+	"placeholder":      builtin.MethodsPlaceholder,
+	"reward":           builtin.MethodsReward,
+	"storagemarket":    builtin.MethodsMarket,
+	"storageminer":     builtin.MethodsMiner,
+	"storagepower":     builtin.MethodsPower,
+	"verifiedregistry": builtin.MethodsVerifiedRegistry,
+}
@@ -0,0 +1,108 @@
+package descriptors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/chain/types/ethtypes"
+	"github.com/ipfs/go-cid"
+)
+
+// Actor behind an address, with its descriptor
+type ResolvedActor struct {
+	Address    string // Address as given
+	ID         string // ID address
+	Code       cid.Cid
+	Head       cid.Cid // CID of the actor state
+	Key        ActorDescriptorKey
+	Descriptor ActorDescriptor
+}
+
+// Parses a Filecoin address of any protocol or an Ethereum address
+func ParseAddress(addr string) (address.Address, error) {
+	if strings.HasPrefix(addr, "0x") {
+		ethAddress, err := ethtypes.ParseEthAddress(addr)
+		if err != nil {
+			return address.Undef, fmt.Errorf("invalid address %s: %w", addr, err)
+		}
+		return ethAddress.ToFilecoinAddress()
+	}
+	a, err := address.NewFromString(addr)
+	if err != nil {
+		return address.Undef, fmt.Errorf("invalid address %s: %w", addr, err)
+	}
+	return a, nil
+}
+
+// Resolves an address to its actor at the pinned tipset or head, and the
+// actor code to a descriptor. Codes missing from the descriptor indexes
+// are looked up in the actor codes of the network.
+func (l *Lotus) ResolveActor(ctx context.Context, d *Descriptors, addr string) (ResolvedActor, error) {
+	a, err := ParseAddress(addr)
+	if err != nil {
+		return ResolvedActor{}, err
+	}
+
+	// Retrieve actor from Lotus
+	var id address.Address
+	err = l.call(ctx, "StateLookupID", func(ctx context.Context) (err error) {
+		id, err = l.api.StateLookupID(ctx, a, l.options.TipSet)
+		return err
+	})
+	if err != nil {
+		return ResolvedActor{}, err
+	}
+	var actor *types.Actor
+	err = l.call(ctx, "StateGetActor", func(ctx context.Context) (err error) {
+		actor, err = l.api.StateGetActor(ctx, id, l.options.TipSet)
+		return err
+	})
+	if err != nil {
+		return ResolvedActor{}, err
+	}
+
+	// Resolve actor code to descriptor
+	key, err := d.GetDescriptorKey(actor.Code.String())
+	if err != nil {
+		var networkErr error
+		key, networkErr = l.getDescriptorKey(ctx, actor.Code.String())
+		if networkErr != nil {
+			return ResolvedActor{}, fmt.Errorf("%w; network actor codes: %w", err, networkErr)
+		}
+	}
+	descriptor, ok := d.Actors[key.Version][key.Name]
+	if !ok {
+		return ResolvedActor{}, fmt.Errorf("no descriptor for %s actor version %d", key.Name, key.Version)
+	}
+
+	return ResolvedActor{
+		Address:    addr,
+		ID:         id.String(),
+		Code:       actor.Code,
+		Head:       actor.Head,
+		Key:        key,
+		Descriptor: descriptor,
+	}, nil
+}
+
+// Looks an actor code up in the actor codes of the network
+func (l *Lotus) getDescriptorKey(ctx context.Context, code ActorCode) (ActorDescriptorKey, error) {
+	actorCodeMap, err := l.GetActorCodeMap(ctx)
+	if err != nil {
+		return ActorDescriptorKey{}, err
+	}
+	for name, actorCode := range actorCodeMap {
+		if actorCode != code {
+			continue
+		}
+		version, err := l.GetActorsVersion(ctx)
+		if err != nil {
+			return ActorDescriptorKey{}, err
+		}
+		return ActorDescriptorKey{Version: version, Name: name}, nil
+	}
+	return ActorDescriptorKey{}, fmt.Errorf("unknown actor code: %s", code)
+}
@@ -0,0 +1,91 @@
+package descriptors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/chain/types/ethtypes"
+)
+
+func TestResolveActor(t *testing.T) {
+	fake, meta := newTestFakeLotusAPI(t)
+	d, err := BuildDescriptors()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// EVM codes are indexed, account codes only known from the network
+	d.Index[meta.Actors["evm"].String()] = ActorDescriptorKey{Version: actorstypes.Version10, Name: "evm"}
+
+	head, err := abi.CidBuilder.Sum([]byte("head"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknownCode, err := abi.CidBuilder.Sum([]byte("unknown"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secp, err := address.NewSecp256k1Address([]byte("public key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ethAddress := ethtypes.EthAddress{0x01, 0x02, 0x03}
+	delegated, err := ethAddress.ToFilecoinAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.Actors = map[address.Address]*types.Actor{
+		mustIDAddress(t, 1000): {Code: meta.Actors["account"], Head: head},
+		mustIDAddress(t, 1001): {Code: meta.Actors["evm"], Head: head},
+		mustIDAddress(t, 1002): {Code: unknownCode, Head: head},
+	}
+	fake.IDs = map[address.Address]address.Address{
+		secp:      mustIDAddress(t, 1000),
+		delegated: mustIDAddress(t, 1001),
+	}
+	lotus := NewLotus(fake, LotusOptions{})
+
+	tests := []struct {
+		address string
+		id      string
+		name    ActorName
+	}{
+		{"f01000", "f01000", "account"},
+		{secp.String(), "f01000", "account"},
+		{ethAddress.String(), "f01001", "evm"},
+		{"0xff000000000000000000000000000000000003e8", "f01000", "account"},
+	}
+	for _, test := range tests {
+		t.Run(test.address, func(t *testing.T) {
+			actor, err := lotus.ResolveActor(context.Background(), d, test.address)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actor.Address != test.address || actor.ID != test.id {
+				t.Errorf("got addresses %s and %s, expected %s and %s", actor.Address, actor.ID, test.address, test.id)
+			}
+			expected := ActorDescriptorKey{Version: actorstypes.Version10, Name: test.name}
+			if actor.Key != expected {
+				t.Errorf("got key %v, expected %v", actor.Key, expected)
+			}
+			if actor.Code != meta.Actors[test.name] || actor.Head != head {
+				t.Errorf("got code %s and head %s", actor.Code, actor.Head)
+			}
+		})
+	}
+
+	// Both the index and network lookups are reported
+	_, err = lotus.ResolveActor(context.Background(), d, "f01002")
+	if err == nil || !strings.Contains(err.Error(), "unknown actor code") || !strings.Contains(err.Error(), "network actor codes") {
+		t.Errorf("got error %v", err)
+	}
+	_, err = lotus.ResolveActor(context.Background(), d, "f01003")
+	if err == nil {
+		t.Error("expected error for missing actor")
+	}
+}
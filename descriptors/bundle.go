@@ -0,0 +1,161 @@
+package descriptors
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/filecoin-project/go-state-types/manifest"
+	"github.com/filecoin-project/lotus/build"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-car"
+)
+
+// Actor codes of a builtin-actors bundle
+type Bundle struct {
+	Network      dtypes.NetworkName
+	Version      ActorsVersion // Zero if unknown
+	ManifestCid  cid.Cid
+	ActorCodeMap ActorCodeMap
+}
+
+func (b Bundle) NetworkManifest() NetworkManifest {
+	return NetworkManifest{
+		ManifestCid:  b.ManifestCid.String(),
+		Version:      b.Version,
+		ActorCodeMap: b.ActorCodeMap,
+	}
+}
+
+// Reads the actor codes from the manifest of a builtin-actors bundle CAR
+// file. The actors version is looked up from the manifest CID of the
+// bundles embedded in Lotus, as the bundle itself does not record it.
+func ReadBundleFile(network dtypes.NetworkName, path string) (Bundle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Bundle{}, err
+	}
+	defer f.Close()
+
+	manifestCid, actorCodeMap, err := readBundleManifest(f)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("failed to read bundle %s: %w", path, err)
+	}
+
+	bundle := Bundle{
+		Network:      network,
+		ManifestCid:  manifestCid,
+		ActorCodeMap: actorCodeMap,
+	}
+	for _, metadata := range build.EmbeddedBuiltinActorsMetadata {
+		if metadata.ManifestCid == manifestCid {
+			bundle.Version = metadata.Version
+			break
+		}
+	}
+
+	return bundle, nil
+}
+
+// Returns the latest builtin-actors bundle embedded in Lotus for each network
+func GetEmbeddedBundles() []Bundle {
+	var bundles []Bundle
+	for _, bundle := range GetAllEmbeddedBundles() {
+		if len(bundles) > 0 && bundles[len(bundles)-1].Network == bundle.Network {
+			bundles[len(bundles)-1] = bundle
+			continue
+		}
+		bundles = append(bundles, bundle)
+	}
+	return bundles
+}
+
+// Returns every builtin-actors bundle embedded in Lotus, sorted by network
+// and actors version
+func GetAllEmbeddedBundles() []Bundle {
+	var bundles []Bundle
+	for _, metadata := range build.EmbeddedBuiltinActorsMetadata {
+		var actorCodeMap = ActorCodeMap{}
+		for name, code := range metadata.Actors {
+			actorCodeMap[name] = code.String()
+		}
+		bundles = append(bundles, Bundle{
+			Network:      dtypes.NetworkName(metadata.Network),
+			Version:      metadata.Version,
+			ManifestCid:  metadata.ManifestCid,
+			ActorCodeMap: actorCodeMap,
+		})
+	}
+	sort.Slice(bundles, func(i, j int) bool {
+		if bundles[i].Network != bundles[j].Network {
+			return bundles[i].Network < bundles[j].Network
+		}
+		return bundles[i].Version < bundles[j].Version
+	})
+
+	return bundles
+}
+
+func readBundleManifest(r io.Reader) (cid.Cid, ActorCodeMap, error) {
+	root, blocks, err := readCarBlocks(r)
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+
+	// The root is the manifest, which links to the manifest data
+	object, ok := blocks[root]
+	if !ok {
+		return cid.Undef, nil, fmt.Errorf("missing manifest %s", root)
+	}
+	var m manifest.Manifest
+	if err := m.UnmarshalCBOR(bytes.NewReader(object)); err != nil {
+		return cid.Undef, nil, err
+	}
+	object, ok = blocks[m.Data]
+	if !ok {
+		return cid.Undef, nil, fmt.Errorf("missing manifest data %s", m.Data)
+	}
+	var data manifest.ManifestData
+	if err := data.UnmarshalCBOR(bytes.NewReader(object)); err != nil {
+		return cid.Undef, nil, err
+	}
+
+	// Every actor code must be in the bundle
+	var actorCodeMap = ActorCodeMap{}
+	for _, entry := range data.Entries {
+		if _, ok := blocks[entry.Code]; !ok {
+			return cid.Undef, nil, fmt.Errorf("actor %s missing from bundle", entry.Name)
+		}
+		actorCodeMap[entry.Name] = entry.Code.String()
+	}
+
+	return root, actorCodeMap, nil
+}
+
+// Reads the single root and all blocks of a CAR file
+func readCarBlocks(r io.Reader) (cid.Cid, map[cid.Cid][]byte, error) {
+	reader, err := car.NewCarReader(r)
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+	if len(reader.Header.Roots) != 1 {
+		return cid.Undef, nil, fmt.Errorf("expected one root, got %d", len(reader.Header.Roots))
+	}
+
+	var blocks = map[cid.Cid][]byte{}
+	for {
+		block, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return cid.Undef, nil, err
+		}
+		blocks[block.Cid()] = block.RawData()
+	}
+
+	return reader.Header.Roots[0], blocks, nil
+}
@@ -0,0 +1,111 @@
+package descriptors
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/manifest"
+	"github.com/filecoin-project/lotus/build"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-car"
+	carutil "github.com/ipld/go-car/util"
+)
+
+func writeTestBundle(t *testing.T, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "bundle.car")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestReadBundleFile(t *testing.T) {
+	data, ok := build.GetEmbeddedBuiltinActorsBundle(actorstypes.Version9)
+	if !ok {
+		t.Fatal("missing embedded v9 bundle")
+	}
+	bundle, err := ReadBundleFile("mainnet", writeTestBundle(t, data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The version is looked up from the metadata of the embedded bundle
+	var meta *build.BuiltinActorsMetadata
+	for _, m := range build.EmbeddedBuiltinActorsMetadata {
+		if m.Network == "mainnet" && m.Version == actorstypes.Version9 {
+			meta = m
+		}
+	}
+	if meta == nil {
+		t.Fatal("missing metadata of mainnet v9 bundle")
+	}
+	if bundle.Network != "mainnet" || bundle.Version != actorstypes.Version9 || bundle.ManifestCid != meta.ManifestCid {
+		t.Errorf("got %s bundle v%d with manifest %s", bundle.Network, bundle.Version, bundle.ManifestCid)
+	}
+	assertActorCodeMap(t, bundle.ActorCodeMap, meta)
+}
+
+// Writes a CAR of a manifest with one actor, including the actor code
+// block if withCode is set
+func newTestBundle(t *testing.T, withCode bool) []byte {
+	t.Helper()
+	blocks := map[cid.Cid][]byte{}
+	put := func(data []byte) cid.Cid {
+		c, err := abi.CidBuilder.Sum(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks[c] = append([]byte(nil), data...)
+		return c
+	}
+
+	code := put([]byte("test actor"))
+	if !withCode {
+		delete(blocks, code)
+	}
+	var buf bytes.Buffer
+	manifestData := manifest.ManifestData{Entries: []manifest.ManifestEntry{{Name: "test", Code: code}}}
+	if err := manifestData.MarshalCBOR(&buf); err != nil {
+		t.Fatal(err)
+	}
+	dataCid := put(buf.Bytes())
+	buf.Reset()
+	m := manifest.Manifest{Version: 1, Data: dataCid}
+	if err := m.MarshalCBOR(&buf); err != nil {
+		t.Fatal(err)
+	}
+	root := put(buf.Bytes())
+
+	var carData bytes.Buffer
+	if err := car.WriteHeader(&car.CarHeader{Roots: []cid.Cid{root}, Version: 1}, &carData); err != nil {
+		t.Fatal(err)
+	}
+	for c, data := range blocks {
+		if err := carutil.LdWrite(&carData, c.Bytes(), data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return carData.Bytes()
+}
+
+func TestReadBundleFileUnknown(t *testing.T) {
+	bundle, err := ReadBundleFile("localnet", writeTestBundle(t, newTestBundle(t, true)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bundle.Version != 0 || len(bundle.ActorCodeMap) != 1 || bundle.ActorCodeMap["test"] == "" {
+		t.Errorf("got bundle v%d with codes %v", bundle.Version, bundle.ActorCodeMap)
+	}
+
+	// Actor codes must be in the bundle
+	_, err = ReadBundleFile("localnet", writeTestBundle(t, newTestBundle(t, false)))
+	if err == nil || !strings.Contains(err.Error(), "actor test missing from bundle") {
+		t.Errorf("got error %v", err)
+	}
+}
@@ -0,0 +1,227 @@
+package descriptors
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-bitfield"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/builtin/v11/market"
+	"github.com/filecoin-project/go-state-types/crypto"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+// Decodes and encodes the CBOR of values whose encoding differs from their
+// DataType, such as types with custom marshalling. Values are decoded to,
+// and encoded from, the JSON values of encoding/json with numbers as
+// json.Number.
+type Codec struct {
+	Decode func(cr *cbg.CborReader, dataType DataType) (interface{}, error)
+	Encode func(cw *cbg.CborWriter, dataType DataType, value interface{}) error
+}
+
+var codecs = struct {
+	sync.RWMutex
+	semantics map[string]Codec
+}{semantics: map[string]Codec{}}
+
+// Registers the codec of a semantic, replacing any previous one. Data
+// types with the semantic are decoded and encoded with the codec, also
+// when loaded from JSON descriptors.
+func RegisterCodec(semantic string, codec Codec) {
+	codecs.Lock()
+	defer codecs.Unlock()
+	codecs.semantics[semantic] = codec
+}
+
+func getCodec(semantic string) (Codec, bool) {
+	if semantic == "" {
+		return Codec{}, false
+	}
+	codecs.RLock()
+	defer codecs.RUnlock()
+	codec, ok := codecs.semantics[semantic]
+	return codec, ok
+}
+
+var addressCodec = Codec{
+	Decode: func(cr *cbg.CborReader, dataType DataType) (interface{}, error) {
+		var addr address.Address
+		if err := addr.UnmarshalCBOR(cr); err != nil {
+			return nil, err
+		}
+		return addr.String(), nil
+	},
+	Encode: func(cw *cbg.CborWriter, dataType DataType, value interface{}) error {
+		str, err := asString(value, dataType)
+		if err != nil {
+			return err
+		}
+		addr, err := address.NewFromString(str)
+		if err != nil {
+			return err
+		}
+		return addr.MarshalCBOR(cw)
+	},
+}
+
+// Also the codec of aliases such as abi.TokenAmount, which have their own
+// semantic
+var bigIntCodec = Codec{
+	Decode: func(cr *cbg.CborReader, dataType DataType) (interface{}, error) {
+		var num big.Int
+		if err := num.UnmarshalCBOR(cr); err != nil {
+			return nil, err
+		}
+		return num.String(), nil
+	},
+	Encode: func(cw *cbg.CborWriter, dataType DataType, value interface{}) error {
+		str, err := asString(value, dataType)
+		if err != nil {
+			return err
+		}
+		num, err := big.FromString(str)
+		if err != nil {
+			return err
+		}
+		return num.MarshalCBOR(cw)
+	},
+}
+
+var bitFieldCodec = Codec{
+	Decode: func(cr *cbg.CborReader, dataType DataType) (interface{}, error) {
+		var bf bitfield.BitField
+		if err := bf.UnmarshalCBOR(cr); err != nil {
+			return nil, err
+		}
+		bits, err := bf.All(MaxBitFieldBits)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", dataType.Name, err)
+		}
+		return bits, nil
+	},
+	Encode: func(cw *cbg.CborWriter, dataType DataType, value interface{}) error {
+		array, err := asArray(value, dataType)
+		if err != nil {
+			return err
+		}
+		bits := make([]uint64, len(array))
+		for i, bit := range array {
+			if bits[i], err = asUint(bit, dataType); err != nil {
+				return err
+			}
+		}
+		bf := bitfield.NewFromSet(bits)
+		return bf.MarshalCBOR(cw)
+	},
+}
+
+var signatureCodec = Codec{
+	Decode: func(cr *cbg.CborReader, dataType DataType) (interface{}, error) {
+		var sig crypto.Signature
+		if err := sig.UnmarshalCBOR(cr); err != nil {
+			return nil, err
+		}
+		object := newObject()
+		object.Set("Type", sig.Type)
+		object.Set("Data", sig.Data)
+		return object, nil
+	},
+	Encode: func(cw *cbg.CborWriter, dataType DataType, value interface{}) error {
+		object, err := asObject(value, dataType)
+		if err != nil {
+			return err
+		}
+		sigType, err := asUint(object["Type"], dataType)
+		if err != nil {
+			return err
+		}
+		sigData, err := decodeBase64(object["Data"])
+		if err != nil {
+			return err
+		}
+		sig := crypto.Signature{Type: crypto.SigType(sigType), Data: sigData}
+		return sig.MarshalCBOR(cw)
+	},
+}
+
+// Labels are strings, or objects with base64 Bytes if not valid UTF-8
+var dealLabelCodec = Codec{
+	Decode: func(cr *cbg.CborReader, dataType DataType) (interface{}, error) {
+		var label market.DealLabel
+		if err := label.UnmarshalCBOR(cr); err != nil {
+			return nil, err
+		}
+		if label.IsString() {
+			return label.ToString()
+		}
+		data, err := label.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		object := newObject()
+		object.Set("Bytes", data)
+		return object, nil
+	},
+	Encode: func(cw *cbg.CborWriter, dataType DataType, value interface{}) error {
+		var label market.DealLabel
+		var err error
+		if str, ok := value.(string); ok {
+			label, err = market.NewLabelFromString(str)
+		} else {
+			var object map[string]interface{}
+			var data []byte
+			if object, err = asObject(value, dataType); err != nil {
+				return err
+			}
+			if data, err = decodeBase64(object["Bytes"]); err != nil {
+				return err
+			}
+			label, err = market.NewLabelFromBytes(data)
+		}
+		if err != nil {
+			return err
+		}
+		return label.MarshalCBOR(cw)
+	},
+}
+
+// GetBytecodeReturn marshals as its CID alone, which is null without
+// bytecode
+var bytecodeReturnCodec = Codec{
+	Decode: func(cr *cbg.CborReader, dataType DataType) (interface{}, error) {
+		c, err := cbg.ReadCid(cr)
+		if err != nil {
+			return nil, err
+		}
+		object := newObject()
+		object.Set("Cid", newCidObject(c.String()))
+		return object, nil
+	},
+	Encode: func(cw *cbg.CborWriter, dataType DataType, value interface{}) error {
+		object, err := asObject(value, dataType)
+		if err != nil {
+			return err
+		}
+		if object["Cid"] == nil {
+			_, err := cw.Write(cbg.CborNull)
+			return err
+		}
+		c, err := asCid(object["Cid"], dataType)
+		if err != nil {
+			return err
+		}
+		return cbg.WriteCid(cw, c)
+	},
+}
+
+// Semantics of aliases and actor package types, whose types have no
+// override to register the codec with
+func init() {
+	for _, semantic := range []string{SemanticTokenAmount, SemanticStoragePower, SemanticDealWeight, SemanticSectorQuality} {
+		RegisterCodec(semantic, bigIntCodec)
+	}
+	RegisterCodec(SemanticDealLabel, dealLabelCodec)
+	RegisterCodec(SemanticBytecodeReturn, bytecodeReturnCodec)
+}
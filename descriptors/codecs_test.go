@@ -0,0 +1,81 @@
+package descriptors
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+// Integer a consumer renders as a hex string
+type testHexNumber uint64
+
+func init() {
+	RegisterTypeOverride(reflect.TypeOf(testHexNumber(0)), TypeOverride{
+		Semantic: "TestHexNumber",
+		Mapper:   mapString,
+		Codec: &Codec{
+			Decode: func(cr *cbg.CborReader, dataType DataType) (interface{}, error) {
+				maj, extra, err := cr.ReadHeader()
+				if err != nil {
+					return nil, err
+				}
+				if maj != cbg.MajUnsignedInt {
+					return nil, fmt.Errorf("expected unsigned integer for %s", dataType.Name)
+				}
+				return fmt.Sprintf("0x%x", extra), nil
+			},
+			Encode: func(cw *cbg.CborWriter, dataType DataType, value interface{}) error {
+				str, err := asString(value, dataType)
+				if err != nil {
+					return err
+				}
+				num, err := strconv.ParseUint(strings.TrimPrefix(str, "0x"), 16, 64)
+				if err != nil {
+					return err
+				}
+				return cw.WriteMajorTypeHeader(cbg.MajUnsignedInt, num)
+			},
+		},
+	})
+}
+
+func TestTypeOverrideCodec(t *testing.T) {
+	dataType, definitions := getTestDataType(testHexNumber(0))
+	if dataType.Type != TypeString || dataType.Semantic != "TestHexNumber" {
+		t.Fatalf("got %s data type with semantic %s", dataType.Type, dataType.Semantic)
+	}
+
+	// The codec applies to data types loaded from JSON, which have no Go type
+	dataTypeJson, err := json.Marshal(dataType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded DataType
+	if err := json.Unmarshal(dataTypeJson, &loaded); err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte{0x18, 0xff}
+	assertDecodedJson(t, loaded, definitions, data, `"0xff"`)
+	encoded, err := EncodeDataType(loaded, definitions, []byte(`"0xff"`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(encoded, data) {
+		t.Errorf("encoded %x, expected %x", encoded, data)
+	}
+}
+
+// Fields declared with an alias of big.Int have the alias semantic, and
+// share its codec
+func TestAliasSemanticCodec(t *testing.T) {
+	dataType := DataType{Type: TypeString, Semantic: SemanticTokenAmount}
+	data := []byte{0x43, 0x00, 0x01, 0x00}
+	assertDecodedJson(t, dataType, DataTypeDefinitions{}, data, `"256"`)
+}
@@ -0,0 +1,33 @@
+package descriptors
+
+import (
+	"fmt"
+
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"github.com/ipfs/go-cid"
+)
+
+// Adds the actor codes of a network and actors version to the index,
+// keeping codes that are already indexed, such as the same bundle running
+// on another network
+func AddActorCodes(index ActorCodeIndex, actors VersionedActorDescriptorMap, network dtypes.NetworkName, version ActorsVersion, actorCodeMap ActorCodeMap) {
+	for name, code := range actorCodeMap {
+		if _, ok := index[code]; ok {
+			continue
+		}
+		entry := ActorCodeEntry{Network: network, Name: name, Version: version}
+		if _, ok := actors[version][name]; ok {
+			entry.DescriptorKey = &ActorDescriptorKey{Version: version, Name: name}
+		}
+		index[code] = entry
+	}
+}
+
+// Returns the network, actor and actors version of an actor code
+func (d *Descriptors) GetActorCodeEntry(code cid.Cid) (ActorCodeEntry, error) {
+	entry, ok := d.CodeIndex[code.String()]
+	if !ok {
+		return entry, fmt.Errorf("unknown actor code: %s", code)
+	}
+	return entry, nil
+}
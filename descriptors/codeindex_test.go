@@ -0,0 +1,69 @@
+package descriptors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/ipfs/go-cid"
+)
+
+func TestActorCodeIndex(t *testing.T) {
+	var codes []cid.Cid
+	for _, name := range []string{"account", "evm", "devnet"} {
+		c, err := abi.CidBuilder.Sum([]byte(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		codes = append(codes, c)
+	}
+	actors := VersionedActorDescriptorMap{actorstypes.Version10: {"account": {}}}
+
+	// The same bundle runs on mainnet and calibrationnet, the first network
+	// is kept
+	index := ActorCodeIndex{}
+	AddActorCodes(index, actors, "mainnet", actorstypes.Version10, ActorCodeMap{"account": codes[0].String(), "evm": codes[1].String()})
+	AddActorCodes(index, actors, "calibrationnet", actorstypes.Version10, ActorCodeMap{"account": codes[0].String(), "evm": codes[1].String()})
+	AddActorCodes(index, actors, "devnet", actorstypes.Version11, ActorCodeMap{"account": codes[2].String()})
+	if len(index) != 3 {
+		t.Fatalf("got %d entries, expected 3", len(index))
+	}
+
+	d := &Descriptors{CodeIndex: index}
+	tests := []struct {
+		code    cid.Cid
+		network string
+		name    string
+		version ActorsVersion
+		key     bool
+	}{
+		{codes[0], "mainnet", "account", actorstypes.Version10, true},
+		{codes[1], "mainnet", "evm", actorstypes.Version10, false},
+		{codes[2], "devnet", "account", actorstypes.Version11, false},
+	}
+	for _, test := range tests {
+		entry, err := d.GetActorCodeEntry(test.code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(entry.Network) != test.network || entry.Name != test.name || entry.Version != test.version {
+			t.Errorf("got %s %s v%d, expected %s %s v%d", entry.Network, entry.Name, entry.Version, test.network, test.name, test.version)
+		}
+
+		// Only versions with descriptors have a descriptor key
+		if (entry.DescriptorKey != nil) != test.key {
+			t.Errorf("%s: got descriptor key %v", test.name, entry.DescriptorKey)
+		} else if test.key && *entry.DescriptorKey != (ActorDescriptorKey{Version: test.version, Name: test.name}) {
+			t.Errorf("%s: got descriptor key %v", test.name, *entry.DescriptorKey)
+		}
+	}
+
+	unknown, err := abi.CidBuilder.Sum([]byte("unknown"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.GetActorCodeEntry(unknown); err == nil || !strings.Contains(err.Error(), "unknown actor code") {
+		t.Errorf("got error %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package descriptors
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"gopkg.in/yaml.v3"
+)
+
+// Configuration of the networks to retrieve actor codes from and the
+// outputs to write, read from a YAML or JSON file:
+//
+//	output: output
+//	outputs: [json, schema, typescript, codes]
+//	timeout: 30s
+//	retries: 3
+//	networks:
+//	  - url: https://api.node.glif.io/rpc/v1
+//	  - name: devnet
+//	    url: https://devnet.example.com/rpc/v1
+//	    token: ${DEVNET_TOKEN}
+//	    tipset: [bafy2bzace...]
+//	    history: true
+//	  - name: localnet
+//	    bundle: builtin-actors-localnet.car
+type Config struct {
+	Output   string          `yaml:"output"`  // Output directory
+	Outputs  []string        `yaml:"outputs"` // Outputs to write, all if empty
+	Timeout  *time.Duration  `yaml:"timeout"` // Deadline of each Lotus RPC call
+	Retries  *int            `yaml:"retries"` // Retries of Lotus RPC calls
+	Networks []NetworkConfig `yaml:"networks"`
+}
+
+type NetworkConfig struct {
+	Name    dtypes.NetworkName `yaml:"name"`    // Overrides the network name reported by Lotus
+	URL     string             `yaml:"url"`     // Lotus RPC endpoint
+	Token   string             `yaml:"token"`   // Lotus RPC auth token, environment variables are expanded
+	TipSet  []string           `yaml:"tipset"`  // Block CIDs of a pinned tipset, the head if empty
+	Bundle  string             `yaml:"bundle"`  // builtin-actors bundle CAR file, instead of a Lotus RPC endpoint
+	History bool               `yaml:"history"` // Also retrieve the actor codes of past upgrades
+}
+
+// Reads a YAML or JSON configuration file, JSON being a subset of YAML
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	// Check networks
+	names := map[dtypes.NetworkName]bool{}
+	for i, network := range config.Networks {
+		if network.Name != "" && names[network.Name] {
+			return nil, fmt.Errorf("network %d in %s has the duplicate name %s", i+1, path, network.Name)
+		}
+		names[network.Name] = true
+		if (network.URL == "") == (network.Bundle == "") {
+			return nil, fmt.Errorf("network %d in %s needs either a url or a bundle", i+1, path)
+		}
+		if network.Bundle != "" && network.History {
+			return nil, fmt.Errorf("network %d in %s needs a url for its history", i+1, path)
+		}
+		if network.Bundle != "" && network.Name == "" {
+			return nil, fmt.Errorf("network %d in %s needs a name for its bundle", i+1, path)
+		}
+		if _, err := network.GetTipSetKey(); err != nil {
+			return nil, fmt.Errorf("network %d in %s: %w", i+1, path, err)
+		}
+	}
+
+	return &config, nil
+}
+
+// Returns whether the output is configured, all outputs are if none are
+func (c *Config) HasOutput(output string) bool {
+	if len(c.Outputs) == 0 {
+		return true
+	}
+	for _, configured := range c.Outputs {
+		if configured == output {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the Lotus endpoint of a network, based on the default options
+func (n NetworkConfig) GetLotusEndpoint(options LotusOptions) (LotusEndpoint, error) {
+	url, token, err := ParseEndpoint(n.URL)
+	if err != nil {
+		return LotusEndpoint{}, err
+	}
+	if n.Token != "" {
+		token = os.ExpandEnv(n.Token)
+	}
+	if token != "" {
+		options.Token = token
+	}
+	if options.TipSet, err = n.GetTipSetKey(); err != nil {
+		return LotusEndpoint{}, err
+	}
+
+	return LotusEndpoint{URL: url, Name: n.Name, Options: options, History: n.History}, nil
+}
+
+func (n NetworkConfig) GetTipSetKey() (types.TipSetKey, error) {
+	return ParseTipSetKey(n.TipSet)
+}
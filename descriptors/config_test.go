@@ -0,0 +1,115 @@
+package descriptors
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testTipSetBlock = "bafy2bzacea3wsdh6y3a36tb3skempjoxqpuyompjbmfeyf34fi3uy6uue42v4"
+
+func writeTestConfig(t *testing.T, name string, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Setenv("TEST_DEVNET_TOKEN", "secret")
+	path := writeTestConfig(t, "networks.yaml", `
+output: out
+outputs: [json, codes]
+timeout: 10s
+retries: 5
+networks:
+  - url: https://token@api.node.glif.io/rpc/v1
+  - name: devnet
+    url: https://devnet.example.com/rpc/v1
+    token: ${TEST_DEVNET_TOKEN}
+    tipset: [`+testTipSetBlock+`]
+    history: true
+  - name: localnet
+    bundle: builtin-actors-localnet.car
+`)
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Output != "out" || *config.Timeout != 10*time.Second || *config.Retries != 5 {
+		t.Errorf("got output %s, timeout %s and retries %d", config.Output, *config.Timeout, *config.Retries)
+	}
+	if !config.HasOutput("codes") || config.HasOutput("schema") {
+		t.Errorf("got outputs %v", config.Outputs)
+	}
+	if len(config.Networks) != 3 {
+		t.Fatalf("got %d networks, expected 3", len(config.Networks))
+	}
+
+	// Tokens are read from the URL, or the token with environment variables
+	// expanded
+	tests := []struct {
+		network NetworkConfig
+		url     string
+		token   string
+	}{
+		{config.Networks[0], "https://api.node.glif.io/rpc/v1", "token"},
+		{config.Networks[1], "https://devnet.example.com/rpc/v1", "secret"},
+	}
+	for _, test := range tests {
+		endpoint, err := test.network.GetLotusEndpoint(DefaultLotusOptions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if endpoint.URL != test.url || endpoint.Options.Token != test.token || endpoint.Name != test.network.Name {
+			t.Errorf("got endpoint %s named %s with token %s", endpoint.URL, endpoint.Name, endpoint.Options.Token)
+		}
+	}
+	devnet, err := config.Networks[1].GetLotusEndpoint(DefaultLotusOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !devnet.History || len(devnet.Options.TipSet.Cids()) != 1 || devnet.Options.TipSet.Cids()[0].String() != testTipSetBlock {
+		t.Errorf("got history %t and tipset %s", devnet.History, devnet.Options.TipSet)
+	}
+}
+
+// JSON is read as YAML
+func TestLoadConfigJson(t *testing.T) {
+	path := writeTestConfig(t, "networks.json", `{"networks": [{"name": "localnet", "bundle": "localnet.car"}]}`)
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Networks) != 1 || config.Networks[0].Name != "localnet" || config.Networks[0].Bundle != "localnet.car" {
+		t.Errorf("got networks %v", config.Networks)
+	}
+}
+
+func TestLoadConfigInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{"Syntax", "networks: [", "failed to parse"},
+		{"UrlAndBundle", "networks: [{name: a, url: https://a, bundle: a.car}]", "either a url or a bundle"},
+		{"NoUrlOrBundle", "networks: [{name: a}]", "either a url or a bundle"},
+		{"BundleHistory", "networks: [{name: a, bundle: a.car, history: true}]", "needs a url for its history"},
+		{"BundleName", "networks: [{bundle: a.car}]", "needs a name for its bundle"},
+		{"TipSet", "networks: [{url: https://a, tipset: [notacid]}]", "invalid tipset block CID"},
+		{"DuplicateName", "networks: [{name: a, url: https://a}, {name: a, bundle: a.car}]", "duplicate name a"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := LoadConfig(writeTestConfig(t, "networks.yaml", test.config))
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, expected %s", err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,377 @@
+package descriptors
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime/codec/dagcbor"
+	"github.com/ipld/go-ipld-prime/datamodel"
+)
+
+// Collection root of a state field, registered in actors.go
+type containerField struct {
+	Kind        string
+	BitWidth    int
+	KeyEncoding string
+	Key         reflect.Type
+	Value       reflect.Type    // Nil for sets
+	Nested      *containerField // Values are roots of another collection
+}
+
+func (f containerField) getContainer(definitions DataTypeDefinitions) Container {
+	container := Container{
+		Kind:        f.Kind,
+		BitWidth:    f.BitWidth,
+		KeyEncoding: f.KeyEncoding,
+	}
+	if f.Key != nil {
+		keyType := GetDataType(f.Key, definitions)
+		container.Key = &keyType
+	}
+	if f.Value != nil {
+		valueType := GetDataType(f.Value, definitions)
+		container.Value = &valueType
+	}
+	if f.Nested != nil {
+		nested := f.Nested.getContainer(definitions)
+		valueType := GetDataType(cidType, definitions)
+		valueType.Container = &nested
+		container.Value = &valueType
+	}
+	return container
+}
+
+// Returns the block of a CID, such as ChainReadObj of a Lotus node
+type BlockSource func(ctx context.Context, c cid.Cid) ([]byte, error)
+
+// Decoded entry of a collection
+type ContainerEntry struct {
+	Key   interface{} // AMT index or decoded HAMT / KAMT key
+	Value interface{} // Nil for sets
+}
+
+// Walks the collection at a root over a block source, calling fn with
+// each decoded entry. Entries are visited in storage order, which is key
+// order for AMTs and hash order for HAMTs. Values that link to nested
+// collections are returned as CIDs, to be walked with their Container.
+func (d *Descriptors) WalkContainer(ctx context.Context, source BlockSource, root cid.Cid, container Container, fn func(entry ContainerEntry) error) error {
+	w := containerWalker{descriptors: d, source: source, container: container, fn: fn}
+	switch container.Kind {
+	case ContainerHamt:
+		return w.walkHamt(ctx, root)
+	case ContainerAmt:
+		return w.walkAmt(ctx, root)
+	case ContainerKamt:
+		return w.walkKamt(ctx, root)
+	}
+	return fmt.Errorf("unknown container kind %s", container.Kind)
+}
+
+type containerWalker struct {
+	descriptors *Descriptors
+	source      BlockSource
+	container   Container
+	fn          func(entry ContainerEntry) error
+}
+
+func (w *containerWalker) walkHamt(ctx context.Context, c cid.Cid) error {
+	data, err := w.source(ctx, c)
+	if err != nil {
+		return err
+	}
+	node, err := DecodeHamtNodeCBOR(data)
+	if err != nil {
+		return fmt.Errorf("failed to decode HAMT node %s: %w", c, err)
+	}
+
+	// Pointers either link to a child node or hold a bucket of entries
+	for _, pointer := range node.Pointers {
+		if pointer.Link != cid.Undef {
+			if err := w.walkHamt(ctx, pointer.Link); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, kv := range pointer.KVs {
+			if err := w.yield(kv.Key, kv.Value.Raw); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AMT roots are [bitWidth, height, count, node], nodes [bitmap, links, values]
+func (w *containerWalker) walkAmt(ctx context.Context, c cid.Cid) error {
+	root, err := w.readNode(ctx, c)
+	if err != nil {
+		return err
+	}
+	if root.Length() != 4 {
+		return fmt.Errorf("invalid AMT root %s", c)
+	}
+	bitWidth, err := getListInt(root, 0)
+	if err != nil {
+		return err
+	}
+	height, err := getListInt(root, 1)
+	if err != nil {
+		return err
+	}
+	node, err := root.LookupByIndex(3)
+	if err != nil {
+		return err
+	}
+	return w.walkAmtNode(ctx, node, uint64(bitWidth), uint64(height), 0)
+}
+
+func (w *containerWalker) walkAmtNode(ctx context.Context, node datamodel.Node, bitWidth uint64, height uint64, offset uint64) error {
+	if node.Length() != 3 {
+		return fmt.Errorf("invalid AMT node")
+	}
+	bitmapNode, err := node.LookupByIndex(0)
+	if err != nil {
+		return err
+	}
+	bitmap, err := bitmapNode.AsBytes()
+	if err != nil {
+		return err
+	}
+	links, err := node.LookupByIndex(1)
+	if err != nil {
+		return err
+	}
+	values, err := node.LookupByIndex(2)
+	if err != nil {
+		return err
+	}
+
+	// Each set bit is a link or value, with lower bits first in each byte
+	var position int64
+	width := uint64(1) << bitWidth
+	for i := uint64(0); i < width; i++ {
+		if int(i/8) >= len(bitmap) || bitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if height == 0 {
+			value, err := values.LookupByIndex(position)
+			if err != nil {
+				return err
+			}
+			data, err := encodeNode(value)
+			if err != nil {
+				return err
+			}
+			if err := w.yieldIndex(offset+i, data); err != nil {
+				return err
+			}
+		} else {
+			link, err := links.LookupByIndex(position)
+			if err != nil {
+				return err
+			}
+			c, err := getLinkCid(link)
+			if err != nil {
+				return err
+			}
+			child, err := w.readNode(ctx, c)
+			if err != nil {
+				return err
+			}
+			span := pow(width, height)
+			if err := w.walkAmtNode(ctx, child, bitWidth, height-1, offset+i*span); err != nil {
+				return err
+			}
+		}
+		position++
+	}
+	return nil
+}
+
+// KAMT nodes are [bitfield, pointers], pointers either a link, a link with
+// an extension as [link, extension], or a bucket of [key, value] entries
+func (w *containerWalker) walkKamt(ctx context.Context, c cid.Cid) error {
+	node, err := w.readNode(ctx, c)
+	if err != nil {
+		return err
+	}
+	if node.Length() != 2 {
+		return fmt.Errorf("invalid KAMT node %s", c)
+	}
+	pointers, err := node.LookupByIndex(1)
+	if err != nil {
+		return err
+	}
+
+	iterator := pointers.ListIterator()
+	for iterator != nil && !iterator.Done() {
+		_, pointer, err := iterator.Next()
+		if err != nil {
+			return err
+		}
+		if pointer.Kind() == datamodel.Kind_Link {
+			if err := w.walkKamtLink(ctx, pointer); err != nil {
+				return err
+			}
+			continue
+		}
+		first, err := pointer.LookupByIndex(0)
+		if err != nil {
+			return err
+		}
+		if first.Kind() == datamodel.Kind_Link {
+			if err := w.walkKamtLink(ctx, first); err != nil {
+				return err
+			}
+			continue
+		}
+		entries := pointer.ListIterator()
+		for !entries.Done() {
+			_, entry, err := entries.Next()
+			if err != nil {
+				return err
+			}
+			key, err := entry.LookupByIndex(0)
+			if err != nil {
+				return err
+			}
+			keyBytes, err := key.AsBytes()
+			if err != nil {
+				return err
+			}
+			value, err := entry.LookupByIndex(1)
+			if err != nil {
+				return err
+			}
+			data, err := encodeNode(value)
+			if err != nil {
+				return err
+			}
+			if err := w.yield(keyBytes, data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (w *containerWalker) walkKamtLink(ctx context.Context, link datamodel.Node) error {
+	c, err := getLinkCid(link)
+	if err != nil {
+		return err
+	}
+	return w.walkKamt(ctx, c)
+}
+
+func (w *containerWalker) readNode(ctx context.Context, c cid.Cid) (datamodel.Node, error) {
+	data, err := w.source(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	node, err := DecodeNodeCBOR(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s node %s: %w", w.container.Kind, c, err)
+	}
+	return node, nil
+}
+
+func (w *containerWalker) yield(key []byte, value []byte) error {
+	decodedKey, err := w.decodeKey(key)
+	if err != nil {
+		return err
+	}
+	return w.yieldKey(decodedKey, value)
+}
+
+func (w *containerWalker) yieldIndex(index uint64, value []byte) error {
+	return w.yieldKey(index, value)
+}
+
+func (w *containerWalker) yieldKey(key interface{}, value []byte) error {
+	entry := ContainerEntry{Key: key}
+	if w.container.Value != nil {
+		var err error
+		entry.Value, err = DecodeDataType(*w.container.Value, w.descriptors.Definitions, value)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s value of key %v: %w", w.container.Kind, key, err)
+		}
+	}
+	return w.fn(entry)
+}
+
+func (w *containerWalker) decodeKey(key []byte) (interface{}, error) {
+	switch w.container.KeyEncoding {
+	case KeyAddress:
+		a, err := address.NewFromBytes(key)
+		if err != nil {
+			return nil, err
+		}
+		return a.String(), nil
+	case KeyUvarint:
+		value, n := binary.Uvarint(key)
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid uvarint key %x", key)
+		}
+		return value, nil
+	case KeyVarint:
+		value, n := binary.Varint(key)
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid varint key %x", key)
+		}
+		return value, nil
+	case KeyCid:
+		c, err := cid.Cast(key)
+		if err != nil {
+			return nil, err
+		}
+		return newCidObject(c.String()), nil
+	case KeyCbor:
+		if w.container.Key == nil {
+			return nil, fmt.Errorf("missing key type")
+		}
+		return DecodeDataType(*w.container.Key, w.descriptors.Definitions, key)
+	}
+	return key, nil
+}
+
+func getListInt(node datamodel.Node, index int64) (int64, error) {
+	item, err := node.LookupByIndex(index)
+	if err != nil {
+		return 0, err
+	}
+	return item.AsInt()
+}
+
+func getLinkCid(node datamodel.Node) (cid.Cid, error) {
+	link, err := node.AsLink()
+	if err != nil {
+		return cid.Undef, err
+	}
+	c, err := cid.Parse(link.String())
+	if err != nil {
+		return cid.Undef, err
+	}
+	return c, nil
+}
+
+// Encodes a node back to CBOR, to decode it with a DataType
+func encodeNode(node datamodel.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := dagcbor.Encode(node, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func pow(base uint64, exponent uint64) uint64 {
+	result := uint64(1)
+	for i := uint64(0); i < exponent; i++ {
+		result *= base
+	}
+	return result
+}
@@ -0,0 +1,229 @@
+package descriptors
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	amt "github.com/filecoin-project/go-amt-ipld/v4"
+	hamt "github.com/filecoin-project/go-hamt-ipld/v3"
+	"github.com/filecoin-project/go-state-types/abi"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	cbor "github.com/ipfs/go-ipld-cbor"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+// In-memory blockstore, also serving as the BlockSource of the walker
+type testBlockstore map[cid.Cid][]byte
+
+func (bs testBlockstore) Get(ctx context.Context, c cid.Cid) (blocks.Block, error) {
+	data, ok := bs[c]
+	if !ok {
+		return nil, fmt.Errorf("block not found: %s", c)
+	}
+	return blocks.NewBlockWithCid(data, c)
+}
+
+func (bs testBlockstore) Put(ctx context.Context, block blocks.Block) error {
+	bs[block.Cid()] = block.RawData()
+	return nil
+}
+
+func (bs testBlockstore) source(ctx context.Context, c cid.Cid) ([]byte, error) {
+	data, ok := bs[c]
+	if !ok {
+		return nil, fmt.Errorf("block not found: %s", c)
+	}
+	return data, nil
+}
+
+// Stores a block written by fn, returning its CID
+func (bs testBlockstore) write(t *testing.T, fn func(cw *cbg.CborWriter) error) cid.Cid {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := fn(cbg.NewCborWriter(&buf)); err != nil {
+		t.Fatal(err)
+	}
+	c, err := abi.CidBuilder.Sum(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs[c] = buf.Bytes()
+	return c
+}
+
+// Walks a container, returning its entries by key, with byte keys as
+// strings
+func walkTestContainer(t *testing.T, bs testBlockstore, root cid.Cid, container Container) map[interface{}]interface{} {
+	t.Helper()
+	d := &Descriptors{Definitions: DataTypeDefinitions{}}
+	entries := map[interface{}]interface{}{}
+	err := d.WalkContainer(context.Background(), bs.source, root, container, func(entry ContainerEntry) error {
+		if key, ok := entry.Key.([]byte); ok {
+			entry.Key = string(key)
+		}
+		if _, ok := entries[entry.Key]; ok {
+			return fmt.Errorf("duplicate key %v", entry.Key)
+		}
+		entries[entry.Key] = entry.Value
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entries
+}
+
+func TestWalkAmt(t *testing.T) {
+	ctx := context.Background()
+	bs := testBlockstore{}
+	array, err := amt.NewAMT(cbor.NewCborStore(bs), amt.UseTreeBitWidth(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sparse indexes span several levels of the tree
+	indexes := []uint64{0, 1, 7, 8, 63, 64, 65, 511, 512, 4095, 12345, 99999, 100000}
+	for _, index := range indexes {
+		value := cbg.CborInt(index * 2)
+		if err := array.Set(ctx, index, &value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	root, err := array.Flush(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := walkTestContainer(t, bs, root, Container{
+		Kind:     ContainerAmt,
+		BitWidth: 3,
+		Value:    &DataType{Type: TypeNumber},
+	})
+	if len(entries) != len(indexes) {
+		t.Fatalf("got %d entries, expected %d", len(entries), len(indexes))
+	}
+	for _, index := range indexes {
+		if entries[index] != index*2 {
+			t.Errorf("got %v at index %d, expected %d", entries[index], index, index*2)
+		}
+	}
+}
+
+func TestWalkHamt(t *testing.T) {
+	ctx := context.Background()
+	bs := testBlockstore{}
+	store := cbor.NewCborStore(bs)
+	node, err := hamt.NewNode(store, hamt.UseTreeBitWidth(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Enough keys to overflow buckets into child nodes
+	for id := uint64(0); id < 200; id++ {
+		value := cbg.CborInt(id + 1)
+		if err := node.Set(ctx, string(mustIDAddress(t, id).Bytes()), &value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := node.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	root, err := store.Put(ctx, node)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := walkTestContainer(t, bs, root, Container{
+		Kind:        ContainerHamt,
+		BitWidth:    5,
+		KeyEncoding: KeyAddress,
+		Value:       &DataType{Type: TypeNumber},
+	})
+	if len(entries) != 200 {
+		t.Fatalf("got %d entries, expected 200", len(entries))
+	}
+	for id := uint64(0); id < 200; id++ {
+		key := mustIDAddress(t, id).String()
+		if entries[key] != id+1 {
+			t.Errorf("got %v for %s, expected %d", entries[key], key, id+1)
+		}
+	}
+}
+
+// KAMT pointers link to a child either alone or with an extension as
+// [cid, extension], which is skipped
+func TestWalkKamt(t *testing.T) {
+	bs := testBlockstore{}
+	writeBucket := func(cw *cbg.CborWriter, key string, value uint64) error {
+		if err := cw.WriteMajorTypeHeader(cbg.MajArray, 1); err != nil {
+			return err
+		}
+		if err := cw.WriteMajorTypeHeader(cbg.MajArray, 2); err != nil {
+			return err
+		}
+		if err := cbg.WriteByteArray(cw, []byte(key)); err != nil {
+			return err
+		}
+		return cw.WriteMajorTypeHeader(cbg.MajUnsignedInt, value)
+	}
+	writeNode := func(pointers ...func(cw *cbg.CborWriter) error) cid.Cid {
+		return bs.write(t, func(cw *cbg.CborWriter) error {
+			if err := cw.WriteMajorTypeHeader(cbg.MajArray, 2); err != nil {
+				return err
+			}
+			if err := cbg.WriteByteArray(cw, []byte{0xff}); err != nil {
+				return err
+			}
+			if err := cw.WriteMajorTypeHeader(cbg.MajArray, uint64(len(pointers))); err != nil {
+				return err
+			}
+			for _, pointer := range pointers {
+				if err := pointer(cw); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	linked := writeNode(func(cw *cbg.CborWriter) error { return writeBucket(cw, "linked", 1) })
+	extended := writeNode(func(cw *cbg.CborWriter) error { return writeBucket(cw, "extended", 2) })
+	root := writeNode(
+		func(cw *cbg.CborWriter) error { return writeBucket(cw, "bucket", 3) },
+		func(cw *cbg.CborWriter) error { return cbg.WriteCid(cw, linked) },
+		func(cw *cbg.CborWriter) error {
+			if err := cw.WriteMajorTypeHeader(cbg.MajArray, 2); err != nil {
+				return err
+			}
+			if err := cbg.WriteCid(cw, extended); err != nil {
+				return err
+			}
+			if err := cw.WriteMajorTypeHeader(cbg.MajArray, 2); err != nil {
+				return err
+			}
+			if err := cw.WriteMajorTypeHeader(cbg.MajUnsignedInt, 8); err != nil {
+				return err
+			}
+			return cbg.WriteByteArray(cw, []byte{0xab})
+		},
+	)
+
+	entries := walkTestContainer(t, bs, root, Container{
+		Kind:        ContainerKamt,
+		BitWidth:    8,
+		KeyEncoding: KeyBytes,
+		Value:       &DataType{Type: TypeNumber},
+	})
+	expected := map[string]uint64{"bucket": 3, "linked": 1, "extended": 2}
+	if len(entries) != len(expected) {
+		t.Fatalf("got %d entries, expected %d", len(entries), len(expected))
+	}
+	for key, value := range expected {
+		if entries[key] != value {
+			t.Errorf("got %v for %s, expected %d", entries[key], key, value)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package descriptors
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/manifest"
+)
+
+// Methods exported by go-state-types that actors do not implement
+var unimplementedMethods = map[ActorName][]string{
+
+	// Placeholder actors have no methods, not even a constructor
+	"placeholder": {"Constructor"},
+}
+
+// Checks that every actor in the manifest of each actors version has a
+// descriptor, and that every method exported by the builtin method numbers
+// has a descriptor in the latest actors version. Deprecated methods have no
+// signature in go-state-types and are exempt.
+func CheckCoverage(versionedActorDescriptorMap VersionedActorDescriptorMap) error {
+	var missing []string
+
+	// Actors by version
+	var latestVersion ActorsVersion
+	for version, actorDescriptorMap := range versionedActorDescriptorMap {
+		for _, name := range manifest.GetBuiltinActorsKeys(version) {
+			if _, ok := actorDescriptorMap[name]; !ok {
+				missing = append(missing, fmt.Sprintf("v%d %s actor", version, name))
+			}
+		}
+		if version > latestVersion {
+			latestVersion = version
+		}
+	}
+
+	// Methods of the latest version
+	actorDescriptorMap := versionedActorDescriptorMap[latestVersion]
+	for name, methods := range builtinMethods {
+		descriptor, ok := actorDescriptorMap[name]
+		if !ok {
+			continue
+		}
+		v := reflect.ValueOf(methods)
+		for i := 0; i < v.NumField(); i++ {
+			methodName := v.Type().Field(i).Name
+			methodNum := abi.MethodNum(v.Field(i).Uint())
+			if _, ok := descriptor.Methods[methodNum]; ok {
+				continue
+			}
+			if strings.HasPrefix(methodName, "Deprecated") || isUnimplementedMethod(name, methodName) {
+				continue
+			}
+			missing = append(missing, fmt.Sprintf("v%d %s method %s (%d)", latestVersion, name, methodName, methodNum))
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("no descriptors for %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func isUnimplementedMethod(name ActorName, methodName string) bool {
+	for _, unimplemented := range unimplementedMethods[name] {
+		if unimplemented == methodName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,333 @@
+package descriptors
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/iancoleman/orderedmap"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+// Maximum number of set bits expanded when decoding a bitfield, which
+// bounds the memory of decoding untrusted run-length encoded bitfields.
+// Larger bitfields fail to decode, raise it to decode them.
+var MaxBitFieldBits uint64 = 1 << 20
+
+func (d *Descriptors) DecodeParams(actor string, methodNum abi.MethodNum, params []byte) (interface{}, error) {
+	method, err := d.GetActorMethod(actor, methodNum)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeDataType(method.Param, d.Definitions, params)
+}
+
+func (d *Descriptors) DecodeReturn(actor string, methodNum abi.MethodNum, ret []byte) (interface{}, error) {
+	method, err := d.GetActorMethod(actor, methodNum)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeDataType(method.Return, d.Definitions, ret)
+}
+
+func (d *Descriptors) DecodeState(actor string, state []byte) (interface{}, error) {
+	descriptor, err := d.GetActorDescriptor(actor)
+	if err != nil {
+		return nil, err
+	}
+	return d.decodeActorState(actor, descriptor, state)
+}
+
+// Decodes actor state with the State of the actor descriptor
+func (d *Descriptors) decodeActorState(actor string, descriptor ActorDescriptor, state []byte) (interface{}, error) {
+	if descriptor.State == nil {
+		return nil, fmt.Errorf("actor %s has no state", actor)
+	}
+	return DecodeDataType(GetStateDataType(descriptor.State), d.Definitions, state)
+}
+
+// Decodes CBOR data to a JSON-serializable value, using the DataType
+// to name object fields. Objects are returned as ordered maps.
+func DecodeDataType(dataType DataType, definitions DataTypeDefinitions, data []byte) (interface{}, error) {
+	dataType, err := ResolveDataType(dataType, definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty data is used for abi.EmptyValue and missing params
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	// Raw bytes are not wrapped in a CBOR header
+	if dataType.Representation == ReprRaw {
+		return data, nil
+	}
+
+	reader := bytes.NewReader(data)
+	value, err := decodeValue(cbg.NewCborReader(reader), dataType, definitions)
+	if err != nil {
+		return nil, err
+	}
+	if reader.Len() > 0 {
+		return nil, fmt.Errorf("%d trailing bytes after decoding %s", reader.Len(), dataType.Name)
+	}
+
+	return value, nil
+}
+
+func decodeValue(cr *cbg.CborReader, dataType DataType, definitions DataTypeDefinitions) (interface{}, error) {
+	dataType, err := ResolveDataType(dataType, definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nil pointers are encoded as CBOR null
+	b, err := cr.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if b == cbg.CborNull[0] {
+		return nil, nil
+	}
+	if err := cr.UnreadByte(); err != nil {
+		return nil, err
+	}
+
+	// Handle types with custom encoding
+	if codec, ok := getCodec(dataType.Semantic); ok {
+		return codec.Decode(cr, dataType)
+	}
+
+	// Handle base types
+	switch dataType.Type {
+
+	case TypeBool:
+		var val cbg.CborBool
+		if err := val.UnmarshalCBOR(cr); err != nil {
+			return nil, err
+		}
+		return bool(val), nil
+
+	case TypeNumber:
+		maj, extra, err := cr.ReadHeader()
+		if err != nil {
+			return nil, err
+		}
+		switch maj {
+		case cbg.MajUnsignedInt:
+			return extra, nil
+		case cbg.MajNegativeInt:
+			if extra > math.MaxInt64 {
+				return nil, fmt.Errorf("int64 negative overflow for %s", dataType.Name)
+			}
+			return -1 - int64(extra), nil
+		}
+		return nil, fmt.Errorf("expected integer for %s, got major type %d", dataType.Name, maj)
+
+	case TypeString:
+		return cbg.ReadString(cr)
+
+	case TypeBytes:
+		return cbg.ReadByteArray(cr, cbg.ByteArrayMaxLen)
+
+	case TypeArray:
+		length, err := readLength(cr, cbg.MajArray, dataType)
+		if err != nil {
+			return nil, err
+		}
+		array := make([]interface{}, length)
+		for i := range array {
+			if array[i], err = decodeValue(cr, *dataType.Contains, definitions); err != nil {
+				return nil, err
+			}
+		}
+		return array, nil
+
+	case TypeMap:
+		length, err := readLength(cr, cbg.MajMap, dataType)
+		if err != nil {
+			return nil, err
+		}
+		object := newObject()
+		for i := uint64(0); i < length; i++ {
+			key, err := decodeValue(cr, *dataType.Key, definitions)
+			if err != nil {
+				return nil, err
+			}
+			val, err := decodeValue(cr, *dataType.Contains, definitions)
+			if err != nil {
+				return nil, err
+			}
+			object.Set(fmt.Sprint(key), val)
+		}
+		return object, nil
+
+	case TypeObject:
+		switch dataType.Representation {
+
+		case ReprLink:
+			c, err := cbg.ReadCid(cr)
+			if err != nil {
+				return nil, err
+			}
+			return newCidObject(c.String()), nil
+
+		case ReprTuple, ReprMap:
+			fields, err := getCborFields(dataType)
+			if err != nil {
+				return nil, err
+			}
+			if dataType.Representation == ReprMap {
+				return decodeMapObject(cr, dataType, definitions, fields)
+			}
+			return decodeTupleObject(cr, dataType, definitions, fields)
+		}
+	}
+
+	return nil, fmt.Errorf("cannot decode %s of type %s", dataType.Name, dataType.Type)
+}
+
+func decodeTupleObject(cr *cbg.CborReader, dataType DataType, definitions DataTypeDefinitions, fields []cborField) (interface{}, error) {
+	length, err := readLength(cr, cbg.MajArray, dataType)
+	if err != nil {
+		return nil, err
+	}
+	if length != uint64(len(fields)) {
+		return nil, fmt.Errorf("expected %d fields for %s, got %d", len(fields), dataType.Name, length)
+	}
+
+	object := newObject()
+	for _, field := range fields {
+		val, err := decodeValue(cr, field.DataType, definitions)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", dataType.Name, field.Name, err)
+		}
+		object.Set(field.Name, val)
+	}
+	return object, nil
+}
+
+func decodeMapObject(cr *cbg.CborReader, dataType DataType, definitions DataTypeDefinitions, fields []cborField) (interface{}, error) {
+	length, err := readLength(cr, cbg.MajMap, dataType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode entries by CBOR key
+	values := map[string]interface{}{}
+	for i := uint64(0); i < length; i++ {
+		key, err := cbg.ReadString(cr)
+		if err != nil {
+			return nil, err
+		}
+		field, ok := findCborField(fields, key)
+		if !ok {
+			return nil, fmt.Errorf("unknown key %s for %s", key, dataType.Name)
+		}
+		if values[field.Name], err = decodeValue(cr, field.DataType, definitions); err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", dataType.Name, field.Name, err)
+		}
+	}
+
+	// Return entries in field order
+	object := newObject()
+	for _, field := range fields {
+		object.Set(field.Name, values[field.Name])
+	}
+	return object, nil
+}
+
+func readLength(cr *cbg.CborReader, expected byte, dataType DataType) (uint64, error) {
+	maj, extra, err := cr.ReadHeader()
+	if err != nil {
+		return 0, err
+	}
+	if maj != expected {
+		return 0, fmt.Errorf("expected major type %d for %s, got %d", expected, dataType.Name, maj)
+	}
+	if extra > cbg.MaxLength {
+		return 0, fmt.Errorf("%s length %d exceeds maximum of %d", dataType.Name, extra, cbg.MaxLength)
+	}
+	return extra, nil
+}
+
+// Object field as encoded in CBOR
+type cborField struct {
+	Name     string
+	Key      string
+	DataType DataType
+}
+
+// Returns the encoded fields of a tuple or map object in CBOR order
+func getCborFields(dataType DataType) ([]cborField, error) {
+	var fields []cborField
+	for _, name := range dataType.Children.Keys() {
+		childType, err := GetDataTypeMapEntry(dataType.Children, name)
+		if err != nil {
+			return nil, err
+		}
+		if childType.CborIndex == nil && childType.CborKey == "" {
+			continue
+		}
+		fields = append(fields, cborField{
+			Name:     name,
+			Key:      childType.CborKey,
+			DataType: childType,
+		})
+	}
+
+	// Tuple fields are ordered by index
+	sort.SliceStable(fields, func(i, j int) bool {
+		a, b := fields[i].DataType.CborIndex, fields[j].DataType.CborIndex
+		return a != nil && b != nil && *a < *b
+	})
+
+	return fields, nil
+}
+
+func findCborField(fields []cborField, key string) (cborField, bool) {
+	for _, field := range fields {
+		if field.Key == key {
+			return field, true
+		}
+	}
+	return cborField{}, false
+}
+
+// Returns the DataType stored under key in a DataTypeMap. Maps loaded
+// from JSON hold generic values, which are converted to a DataType.
+func GetDataTypeMapEntry(dataTypeMap DataTypeMap, key string) (DataType, error) {
+	var dataType DataType
+
+	value, ok := dataTypeMap.Get(key)
+	if !ok {
+		return dataType, fmt.Errorf("missing data type for %s", key)
+	}
+
+	switch value := value.(type) {
+	case DataType:
+		return value, nil
+	case *DataType:
+		return *value, nil
+	}
+
+	if err := MapToInterface(value, &dataType); err != nil {
+		return dataType, err
+	}
+	return dataType, nil
+}
+
+func newObject() *orderedmap.OrderedMap {
+	object := orderedmap.New()
+	object.SetEscapeHTML(false)
+	return object
+}
+
+func newCidObject(c string) *orderedmap.OrderedMap {
+	object := newObject()
+	object.Set("/", c)
+	return object
+}
@@ -0,0 +1,134 @@
+package descriptors
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/builtin/v11/market"
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/iancoleman/orderedmap"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+// Returns the DataType of a value and the definitions it references
+func getTestDataType(value interface{}) (DataType, DataTypeDefinitions) {
+	definitions := DataTypeDefinitions{}
+	return GetDataType(reflect.TypeOf(value), definitions), definitions
+}
+
+func marshalTestValue(t *testing.T, value cbg.CBORMarshaler) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := value.MarshalCBOR(&buf); err != nil {
+		t.Fatalf("failed to marshal %T: %v", value, err)
+	}
+	return buf.Bytes()
+}
+
+func assertDecodedJson(t *testing.T, dataType DataType, definitions DataTypeDefinitions, data []byte, expected string) {
+	t.Helper()
+	value, err := DecodeDataType(dataType, definitions, data)
+	if err != nil {
+		t.Fatalf("failed to decode: %v", err)
+	}
+	valueJson, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("failed to marshal JSON: %v", err)
+	}
+	if string(valueJson) != expected {
+		t.Errorf("decoded %s, expected %s", valueJson, expected)
+	}
+}
+
+func TestDecodeTuple(t *testing.T) {
+	params := &miner.ChangeWorkerAddressParams{
+		NewWorker:       mustIDAddress(t, 1234),
+		NewControlAddrs: []address.Address{mustIDAddress(t, 100)},
+	}
+	dataType, definitions := getTestDataType(params)
+	assertDecodedJson(t, dataType, definitions, marshalTestValue(t, params),
+		`{"NewWorker":"f01234","NewControlAddrs":["f0100"]}`)
+}
+
+func TestDecodeMap(t *testing.T) {
+	children := orderedmap.New()
+	children.Set("Count", DataType{Type: TypeNumber, CborKey: "count"})
+	children.Set("Name", DataType{Type: TypeString, CborKey: "name"})
+	children.Set("skipped", DataType{Type: TypeString})
+	dataType := DataType{Type: TypeObject, Name: "Entry", Representation: ReprMap, Children: children}
+
+	// Keys are matched by name, not by position
+	var buf bytes.Buffer
+	cw := cbg.NewCborWriter(&buf)
+	for _, err := range []error{
+		cw.WriteMajorTypeHeader(cbg.MajMap, 2),
+		encodeValue(cw, DataType{Type: TypeString}, nil, "name"),
+		encodeValue(cw, DataType{Type: TypeString}, nil, "entry"),
+		encodeValue(cw, DataType{Type: TypeString}, nil, "count"),
+		cw.WriteMajorTypeHeader(cbg.MajNegativeInt, 4),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	assertDecodedJson(t, dataType, DataTypeDefinitions{}, buf.Bytes(), `{"Count":-5,"Name":"entry"}`)
+}
+
+func TestDecodeDealLabel(t *testing.T) {
+	stringLabel, err := market.NewLabelFromString("label")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bytesLabel, err := market.NewLabelFromBytes([]byte{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dataType, definitions := getTestDataType(&stringLabel)
+	assertDecodedJson(t, dataType, definitions, marshalTestValue(t, &stringLabel), `"label"`)
+	assertDecodedJson(t, dataType, definitions, marshalTestValue(t, &bytesLabel), `{"Bytes":"AQI="}`)
+}
+
+func TestDecodeSignature(t *testing.T) {
+	sig := &crypto.Signature{Type: crypto.SigTypeBLS, Data: []byte{1, 2}}
+	dataType, definitions := getTestDataType(sig)
+	assertDecodedJson(t, dataType, definitions, marshalTestValue(t, sig), `{"Type":2,"Data":"AQI="}`)
+}
+
+func TestDecodeNilPointer(t *testing.T) {
+	sealedCid, err := abi.CidBuilder.Sum([]byte("sealed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := &miner.SectorPreCommitInfo{
+		SealProof:     abi.RegisteredSealProof_StackedDrg32GiBV1_1,
+		SectorNumber:  1,
+		SealedCID:     sealedCid,
+		SealRandEpoch: 10,
+		Expiration:    20,
+	}
+	dataType, definitions := getTestDataType(info)
+	assertDecodedJson(t, dataType, definitions, marshalTestValue(t, info),
+		`{"SealProof":8,"SectorNumber":1,"SealedCID":{"/":"`+sealedCid.String()+`"},"SealRandEpoch":10,"DealIDs":[],"Expiration":20,"UnsealedCid":null}`)
+}
+
+func TestDecodeNegativeOverflow(t *testing.T) {
+	data := []byte{0x3b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	if _, err := DecodeDataType(DataType{Type: TypeNumber}, DataTypeDefinitions{}, data); err == nil {
+		t.Error("expected overflow error")
+	}
+}
+
+func mustIDAddress(t *testing.T, id uint64) address.Address {
+	t.Helper()
+	a, err := address.NewIDAddress(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
@@ -0,0 +1,185 @@
+//go:generate go run ../gen
+
+// Package descriptors describes the state and method params and return
+// values of the Filecoin builtin actors, derived from go-state-types by
+// reflection, and decodes and encodes CBOR data with these descriptors.
+package descriptors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-cid"
+)
+
+type Descriptors struct {
+	Actors      VersionedActorDescriptorMap
+	Definitions DataTypeDefinitions
+	Index       ActorDescriptorIndex
+	Codes       NetworkActorCodeMap
+	CodeIndex   ActorCodeIndex
+}
+
+// Builds the descriptors of all actors versions in the registry. The index
+// is empty, as actor codes are only known from a network or bundle.
+func BuildDescriptors() (*Descriptors, error) {
+	var descriptors = Descriptors{
+		Definitions: DataTypeDefinitions{},
+		Index:       ActorDescriptorIndex{},
+		Codes:       NetworkActorCodeMap{},
+		CodeIndex:   ActorCodeIndex{},
+	}
+
+	var err error
+	if descriptors.Actors, err = GetVersionedActorDescriptorMap(descriptors.Definitions); err != nil {
+		return nil, err
+	}
+
+	return &descriptors, nil
+}
+
+// Loads the descriptors written by the descriptors and codes commands
+func LoadDescriptors(dir string) (*Descriptors, error) {
+	return LoadDescriptorsFS(os.DirFS(dir))
+}
+
+// Loads the descriptors from a file system, such as an embedded one
+func LoadDescriptorsFS(fsys fs.FS) (*Descriptors, error) {
+	var descriptors Descriptors
+
+	if err := readJsonFile(fsys, "actor-descriptors.json", &descriptors.Actors); err != nil {
+		return nil, err
+	}
+
+	if err := readJsonFile(fsys, "type-definitions.json", &descriptors.Definitions); err != nil {
+		return nil, err
+	}
+
+	// The indexes and codes are only written when actor codes are retrieved
+	descriptors.Index = ActorDescriptorIndex{}
+	err := readJsonFile(fsys, "actor-descriptor-index.json", &descriptors.Index)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	descriptors.Codes = NetworkActorCodeMap{}
+	err = readJsonFile(fsys, "actor-codes.json", &descriptors.Codes)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	descriptors.CodeIndex = ActorCodeIndex{}
+	err = readJsonFile(fsys, "code-index.json", &descriptors.CodeIndex)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	return &descriptors, nil
+}
+
+// Resolves an actor code CID or actor name to a descriptor key. Actor
+// names resolve to the latest actors version that contains the actor.
+func (d *Descriptors) GetDescriptorKey(actor string) (ActorDescriptorKey, error) {
+	var key ActorDescriptorKey
+	var found bool
+
+	// Resolve actor name
+	for version, actorDescriptorMap := range d.Actors {
+		if _, ok := actorDescriptorMap[actor]; ok && (!found || version > key.Version) {
+			key = ActorDescriptorKey{Version: version, Name: actor}
+			found = true
+		}
+	}
+	if found {
+		return key, nil
+	}
+
+	// Resolve actor code
+	code, err := cid.Decode(actor)
+	if err != nil {
+		return key, fmt.Errorf("unknown actor name or invalid actor code: %s", actor)
+	}
+	key, ok := d.Index[code.String()]
+	if ok {
+		return key, nil
+	}
+
+	// Codes of past actors versions are only in the code index
+	entry, ok := d.CodeIndex[code.String()]
+	if !ok {
+		return key, fmt.Errorf("unknown actor code: %s", code)
+	}
+	if entry.DescriptorKey == nil {
+		return key, fmt.Errorf("no descriptors for actor code %s of %s actor version %d", code, entry.Name, entry.Version)
+	}
+
+	return *entry.DescriptorKey, nil
+}
+
+func (d *Descriptors) GetActorDescriptor(actor string) (ActorDescriptor, error) {
+	key, err := d.GetDescriptorKey(actor)
+	if err != nil {
+		return ActorDescriptor{}, err
+	}
+
+	descriptor, ok := d.Actors[key.Version][key.Name]
+	if !ok {
+		return ActorDescriptor{}, fmt.Errorf("no descriptor for %s actor version %d", key.Name, key.Version)
+	}
+
+	return descriptor, nil
+}
+
+func (d *Descriptors) GetActorMethod(actor string, methodNum abi.MethodNum) (ActorMethod, error) {
+	descriptor, err := d.GetActorDescriptor(actor)
+	if err != nil {
+		return ActorMethod{}, err
+	}
+
+	method, ok := descriptor.Methods[methodNum]
+	if !ok {
+		return ActorMethod{}, fmt.Errorf("actor %s has no method %d", actor, methodNum)
+	}
+
+	return method, nil
+}
+
+// Returns the state of an actor as object DataType
+func (d *Descriptors) GetStateType(actor string) (DataType, error) {
+	descriptor, err := d.GetActorDescriptor(actor)
+	if err != nil {
+		return DataType{}, err
+	}
+	if descriptor.State == nil {
+		return DataType{}, fmt.Errorf("actor %s has no state", actor)
+	}
+	return GetStateDataType(descriptor.State), nil
+}
+
+// Actor states are structs named State, encoded as tuples
+func GetStateDataType(state DataTypeMap) DataType {
+	return DataType{
+		Type:           TypeObject,
+		Name:           "State",
+		Children:       state,
+		Representation: ReprTuple,
+	}
+}
+
+func readJsonFile(fsys fs.FS, path string, data interface{}) error {
+
+	// Read file
+	dataJson, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return err
+	}
+
+	// Unmarshal JSON to data
+	if err := json.Unmarshal(dataJson, data); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,279 @@
+package descriptors
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/iancoleman/orderedmap"
+)
+
+// Descriptor changes
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+type DescriptorChange struct {
+	Change string
+	Actor  ActorName
+	Method *abi.MethodNum `json:",omitempty"`
+	Name   string         `json:",omitempty"` // Method or state field name
+	Part   string         `json:",omitempty"` // Changed part of a method: Name, Param or Return
+}
+
+func (c DescriptorChange) String() string {
+	symbol := map[string]string{ChangeAdded: "+", ChangeRemoved: "-", ChangeChanged: "~"}[c.Change]
+	switch {
+	case c.Method != nil && c.Part != "":
+		return fmt.Sprintf("%s %s method %d %s %s", symbol, c.Actor, *c.Method, c.Name, c.Part)
+	case c.Method != nil:
+		return fmt.Sprintf("%s %s method %d %s", symbol, c.Actor, *c.Method, c.Name)
+	case c.Name != "":
+		return fmt.Sprintf("%s %s state %s", symbol, c.Actor, c.Name)
+	}
+	return fmt.Sprintf("%s %s", symbol, c.Actor)
+}
+
+// Returns the changes to actors, their state fields and their methods
+// between two actors versions. Types are compared by structure, so that
+// the same type in another versioned package is not a change.
+func (d *Descriptors) Diff(from ActorsVersion, to ActorsVersion) ([]DescriptorChange, error) {
+	fromMap, ok := d.Actors[from]
+	if !ok {
+		return nil, fmt.Errorf("no descriptors for actors version %d", from)
+	}
+	toMap, ok := d.Actors[to]
+	if !ok {
+		return nil, fmt.Errorf("no descriptors for actors version %d", to)
+	}
+
+	var changes []DescriptorChange
+	for _, name := range getSortedActorNames(fromMap, toMap) {
+		fromActor, inFrom := fromMap[name]
+		toActor, inTo := toMap[name]
+		switch {
+		case !inFrom:
+			changes = append(changes, DescriptorChange{Change: ChangeAdded, Actor: name})
+			continue
+		case !inTo:
+			changes = append(changes, DescriptorChange{Change: ChangeRemoved, Actor: name})
+			continue
+		}
+
+		// State fields
+		stateChanges, err := d.diffDataTypeMaps(fromActor.State, toActor.State)
+		if err != nil {
+			return nil, fmt.Errorf("%s state: %w", name, err)
+		}
+		for _, change := range stateChanges {
+			change.Actor = name
+			changes = append(changes, change)
+		}
+
+		// Methods
+		for _, methodNum := range getSortedMethodNums(mergeActorMethodMaps(fromActor.Methods, toActor.Methods)) {
+			methodNum := methodNum
+			fromMethod, inFrom := fromActor.Methods[methodNum]
+			toMethod, inTo := toActor.Methods[methodNum]
+			switch {
+			case !inFrom:
+				changes = append(changes, DescriptorChange{Change: ChangeAdded, Actor: name, Method: &methodNum, Name: toMethod.Name})
+				continue
+			case !inTo:
+				changes = append(changes, DescriptorChange{Change: ChangeRemoved, Actor: name, Method: &methodNum, Name: fromMethod.Name})
+				continue
+			}
+
+			if fromMethod.Name != toMethod.Name {
+				changes = append(changes, DescriptorChange{Change: ChangeChanged, Actor: name, Method: &methodNum, Name: toMethod.Name, Part: "Name"})
+			}
+			for _, part := range []struct {
+				name     string
+				from, to DataType
+			}{{"Param", fromMethod.Param, toMethod.Param}, {"Return", fromMethod.Return, toMethod.Return}} {
+				equal, err := d.isSameDataType(part.from, part.to)
+				if err != nil {
+					return nil, fmt.Errorf("%s method %s: %w", name, toMethod.Name, err)
+				}
+				if !equal {
+					changes = append(changes, DescriptorChange{Change: ChangeChanged, Actor: name, Method: &methodNum, Name: toMethod.Name, Part: part.name})
+				}
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+func (d *Descriptors) diffDataTypeMaps(from DataTypeMap, to DataTypeMap) ([]DescriptorChange, error) {
+	var fromKeys, toKeys []string
+	if from != nil {
+		fromKeys = from.Keys()
+	}
+	if to != nil {
+		toKeys = to.Keys()
+	}
+
+	var changes []DescriptorChange
+	for _, key := range fromKeys {
+		if _, ok := to.Get(key); to == nil || !ok {
+			changes = append(changes, DescriptorChange{Change: ChangeRemoved, Name: key})
+		}
+	}
+	for _, key := range toKeys {
+		if _, ok := from.Get(key); from == nil || !ok {
+			changes = append(changes, DescriptorChange{Change: ChangeAdded, Name: key})
+			continue
+		}
+		fromType, err := GetDataTypeMapEntry(from, key)
+		if err != nil {
+			return nil, err
+		}
+		toType, err := GetDataTypeMapEntry(to, key)
+		if err != nil {
+			return nil, err
+		}
+		equal, err := d.isSameDataType(fromType, toType)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			changes = append(changes, DescriptorChange{Change: ChangeChanged, Name: key})
+		}
+	}
+
+	return changes, nil
+}
+
+func (d *Descriptors) isSameDataType(a DataType, b DataType) (bool, error) {
+	aType, err := d.inlineDataType(a, map[TypeId]bool{})
+	if err != nil {
+		return false, err
+	}
+	bType, err := d.inlineDataType(b, map[TypeId]bool{})
+	if err != nil {
+		return false, err
+	}
+	aJson, err := json.Marshal(aType)
+	if err != nil {
+		return false, err
+	}
+	bJson, err := json.Marshal(bType)
+	if err != nil {
+		return false, err
+	}
+	return string(aJson) == string(bJson), nil
+}
+
+// Returns the DataType with its references replaced by their definitions,
+// except for cyclic references, which are reduced to the type name
+func (d *Descriptors) inlineDataType(dataType DataType, seen map[TypeId]bool) (DataType, error) {
+	if dataType.Type == TypeRef {
+		ref := dataType.Ref
+		if seen[ref] {
+			dataType.Ref = ""
+			return dataType, nil
+		}
+		definition, err := ResolveDataType(dataType, d.Definitions)
+		if err != nil {
+			return dataType, err
+		}
+		seen[ref] = true
+		defer delete(seen, ref)
+
+		// The reference holds the properties of its use
+		definition.Nullable = dataType.Nullable
+		definition.CborIndex = dataType.CborIndex
+		definition.CborKey = dataType.CborKey
+		dataType = definition
+	}
+
+	var err error
+	if dataType.Key != nil {
+		key, err := d.inlineDataType(*dataType.Key, seen)
+		if err != nil {
+			return dataType, err
+		}
+		dataType.Key = &key
+	}
+	if dataType.Contains != nil {
+		contains, err := d.inlineDataType(*dataType.Contains, seen)
+		if err != nil {
+			return dataType, err
+		}
+		dataType.Contains = &contains
+	}
+	if dataType.Children, err = d.inlineDataTypeMap(dataType.Children, seen); err != nil {
+		return dataType, err
+	}
+	if dataType.Methods, err = d.inlineDataTypeMap(dataType.Methods, seen); err != nil {
+		return dataType, err
+	}
+	if dataType.Params, err = d.inlineDataTypes(dataType.Params, seen); err != nil {
+		return dataType, err
+	}
+	if dataType.Returns, err = d.inlineDataTypes(dataType.Returns, seen); err != nil {
+		return dataType, err
+	}
+
+	return dataType, nil
+}
+
+func (d *Descriptors) inlineDataTypeMap(dataTypeMap DataTypeMap, seen map[TypeId]bool) (DataTypeMap, error) {
+	if dataTypeMap == nil {
+		return nil, nil
+	}
+	inlined := orderedmap.New()
+	inlined.SetEscapeHTML(false)
+	for _, key := range dataTypeMap.Keys() {
+		entry, err := GetDataTypeMapEntry(dataTypeMap, key)
+		if err != nil {
+			return nil, err
+		}
+		if entry, err = d.inlineDataType(entry, seen); err != nil {
+			return nil, err
+		}
+		inlined.Set(key, entry)
+	}
+	return inlined, nil
+}
+
+func (d *Descriptors) inlineDataTypes(dataTypes []DataType, seen map[TypeId]bool) ([]DataType, error) {
+	var inlined []DataType
+	for _, dataType := range dataTypes {
+		dataType, err := d.inlineDataType(dataType, seen)
+		if err != nil {
+			return nil, err
+		}
+		inlined = append(inlined, dataType)
+	}
+	return inlined, nil
+}
+
+func getSortedActorNames(actorDescriptorMaps ...ActorDescriptorMap) []ActorName {
+	var names []ActorName
+	seen := map[ActorName]bool{}
+	for _, actorDescriptorMap := range actorDescriptorMaps {
+		for name := range actorDescriptorMap {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func mergeActorMethodMaps(actorMethodMaps ...ActorMethodMap) ActorMethodMap {
+	var merged = ActorMethodMap{}
+	for _, actorMethodMap := range actorMethodMaps {
+		for methodNum, method := range actorMethodMap {
+			merged[methodNum] = method
+		}
+	}
+	return merged
+}
@@ -0,0 +1,302 @@
+package descriptors
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/ipfs/go-cid"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+func (d *Descriptors) EncodeParams(actor string, methodNum abi.MethodNum, params []byte) ([]byte, error) {
+	method, err := d.GetActorMethod(actor, methodNum)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeDataType(method.Param, d.Definitions, params)
+}
+
+func (d *Descriptors) EncodeReturn(actor string, methodNum abi.MethodNum, ret []byte) ([]byte, error) {
+	method, err := d.GetActorMethod(actor, methodNum)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeDataType(method.Return, d.Definitions, ret)
+}
+
+// Encodes JSON data shaped like the DataType to the CBOR bytes produced
+// by the corresponding go-state-types MarshalCBOR implementation.
+func EncodeDataType(dataType DataType, definitions DataTypeDefinitions, data []byte) ([]byte, error) {
+	dataType, err := ResolveDataType(dataType, definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse JSON, keeping numbers exact
+	var value interface{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+	}
+
+	// Empty values are encoded as empty bytes
+	if dataType.Representation == ReprEmpty {
+		if value != nil {
+			return nil, fmt.Errorf("expected null for %s", dataType.Name)
+		}
+		return []byte{}, nil
+	}
+
+	// Raw bytes are not wrapped in a CBOR header
+	if dataType.Representation == ReprRaw {
+		return decodeBase64(value)
+	}
+
+	var buf bytes.Buffer
+	if err := encodeValue(cbg.NewCborWriter(&buf), dataType, definitions, value); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func encodeValue(cw *cbg.CborWriter, dataType DataType, definitions DataTypeDefinitions, value interface{}) error {
+	dataType, err := ResolveDataType(dataType, definitions)
+	if err != nil {
+		return err
+	}
+
+	// Nil pointers are encoded as CBOR null
+	if value == nil {
+		_, err := cw.Write(cbg.CborNull)
+		return err
+	}
+
+	// Handle types with custom encoding
+	if codec, ok := getCodec(dataType.Semantic); ok {
+		return codec.Encode(cw, dataType, value)
+	}
+
+	// Handle base types
+	switch dataType.Type {
+
+	case TypeBool:
+		val, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("expected boolean for %s, got %T", dataType.Name, value)
+		}
+		return cbg.WriteBool(cw, val)
+
+	case TypeNumber:
+		num, ok := value.(json.Number)
+		if !ok {
+			return fmt.Errorf("expected number for %s, got %T", dataType.Name, value)
+		}
+		if val, err := strconv.ParseUint(num.String(), 10, 64); err == nil {
+			return cw.WriteMajorTypeHeader(cbg.MajUnsignedInt, val)
+		}
+		val, err := strconv.ParseInt(num.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer for %s: %s", dataType.Name, num)
+		}
+		return cw.WriteMajorTypeHeader(cbg.MajNegativeInt, uint64(-val-1))
+
+	case TypeString:
+		str, err := asString(value, dataType)
+		if err != nil {
+			return err
+		}
+		if err := cw.WriteMajorTypeHeader(cbg.MajTextString, uint64(len(str))); err != nil {
+			return err
+		}
+		_, err = cw.WriteString(str)
+		return err
+
+	case TypeBytes:
+		data, err := decodeBase64(value)
+		if err != nil {
+			return err
+		}
+		return cbg.WriteByteArray(cw, data)
+
+	case TypeArray:
+		array, err := asArray(value, dataType)
+		if err != nil {
+			return err
+		}
+		if err := cw.WriteMajorTypeHeader(cbg.MajArray, uint64(len(array))); err != nil {
+			return err
+		}
+		for _, item := range array {
+			if err := encodeValue(cw, *dataType.Contains, definitions, item); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case TypeMap:
+		object, err := asObject(value, dataType)
+		if err != nil {
+			return err
+		}
+
+		// Encode entries in the sort.Strings order of their keys, as
+		// cbor-gen does for the string keyed maps it supports, rather
+		// than the length-first order of canonical CBOR
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		type entry struct{ key, val []byte }
+		entries := make([]entry, 0, len(keys))
+		for _, key := range keys {
+			var keyBuf, valBuf bytes.Buffer
+			var keyValue interface{} = key
+			if dataType.Key.Type == TypeNumber {
+				keyValue = json.Number(key)
+			}
+			if err := encodeValue(cbg.NewCborWriter(&keyBuf), *dataType.Key, definitions, keyValue); err != nil {
+				return err
+			}
+			if err := encodeValue(cbg.NewCborWriter(&valBuf), *dataType.Contains, definitions, object[key]); err != nil {
+				return err
+			}
+			entries = append(entries, entry{keyBuf.Bytes(), valBuf.Bytes()})
+		}
+
+		if err := cw.WriteMajorTypeHeader(cbg.MajMap, uint64(len(entries))); err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if _, err := cw.Write(e.key); err != nil {
+				return err
+			}
+			if _, err := cw.Write(e.val); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case TypeObject:
+		switch dataType.Representation {
+
+		case ReprLink:
+			c, err := asCid(value, dataType)
+			if err != nil {
+				return err
+			}
+			return cbg.WriteCid(cw, c)
+
+		case ReprTuple, ReprMap:
+			object, err := asObject(value, dataType)
+			if err != nil {
+				return err
+			}
+			fields, err := getCborFields(dataType)
+			if err != nil {
+				return err
+			}
+			major := byte(cbg.MajArray)
+			if dataType.Representation == ReprMap {
+				major = cbg.MajMap
+
+				// cbor-gen writes map keys by length then key, the
+				// canonical order of RFC 7049
+				sort.SliceStable(fields, func(i, j int) bool {
+					a, b := fields[i].Key, fields[j].Key
+					if len(a) != len(b) {
+						return len(a) < len(b)
+					}
+					return a < b
+				})
+			}
+			if err := cw.WriteMajorTypeHeader(major, uint64(len(fields))); err != nil {
+				return err
+			}
+			for _, field := range fields {
+				val, ok := object[field.Name]
+				if !ok {
+					return fmt.Errorf("missing field %s.%s", dataType.Name, field.Name)
+				}
+				if dataType.Representation == ReprMap {
+					if err := encodeValue(cw, DataType{Type: TypeString}, definitions, field.Key); err != nil {
+						return err
+					}
+				}
+				if err := encodeValue(cw, field.DataType, definitions, val); err != nil {
+					return fmt.Errorf("%s.%s: %w", dataType.Name, field.Name, err)
+				}
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot encode %s of type %s", dataType.Name, dataType.Type)
+}
+
+func asString(value interface{}, dataType DataType) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	}
+	return "", fmt.Errorf("expected string for %s, got %T", dataType.Name, value)
+}
+
+func asUint(value interface{}, dataType DataType) (uint64, error) {
+	num, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("expected number for %s, got %T", dataType.Name, value)
+	}
+	return strconv.ParseUint(num.String(), 10, 64)
+}
+
+func asArray(value interface{}, dataType DataType) ([]interface{}, error) {
+	array, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected array for %s, got %T", dataType.Name, value)
+	}
+	return array, nil
+}
+
+func asObject(value interface{}, dataType DataType) (map[string]interface{}, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected object for %s, got %T", dataType.Name, value)
+	}
+	return object, nil
+}
+
+// Accepts both {"/": "<cid>"} link objects and plain CID strings
+func asCid(value interface{}, dataType DataType) (cid.Cid, error) {
+	if object, ok := value.(map[string]interface{}); ok {
+		value = object["/"]
+	}
+	str, ok := value.(string)
+	if !ok {
+		return cid.Undef, fmt.Errorf("expected CID for %s, got %T", dataType.Name, value)
+	}
+	return cid.Decode(str)
+}
+
+// Bytes are represented as base64 strings, matching encoding/json
+func decodeBase64(value interface{}) ([]byte, error) {
+	if value == nil {
+		return []byte{}, nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected base64 string, got %T", value)
+	}
+	return base64.StdEncoding.DecodeString(str)
+}
@@ -0,0 +1,171 @@
+package descriptors
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-bitfield"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/builtin/v11/evm"
+	"github.com/filecoin-project/go-state-types/builtin/v11/market"
+	"github.com/filecoin-project/go-state-types/builtin/v11/miner"
+	"github.com/filecoin-project/go-state-types/builtin/v11/multisig"
+	"github.com/filecoin-project/go-state-types/builtin/v11/verifreg"
+	"github.com/filecoin-project/go-state-types/crypto"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+// Struct with a map representation, marshalled with its keys in the
+// canonical order of cbor-gen rather than field order
+type testMapStruct struct {
+	Value uint64
+	Id    uint64
+	Label string `cborgen:"lbl"`
+}
+
+func (s *testMapStruct) MarshalCBOR(w io.Writer) error {
+	cw := cbg.NewCborWriter(w)
+	writeString := func(str string) error {
+		if err := cw.WriteMajorTypeHeader(cbg.MajTextString, uint64(len(str))); err != nil {
+			return err
+		}
+		_, err := io.WriteString(cw, str)
+		return err
+	}
+	if err := cw.WriteMajorTypeHeader(cbg.MajMap, 3); err != nil {
+		return err
+	}
+	if err := writeString("Id"); err != nil {
+		return err
+	}
+	if err := cw.WriteMajorTypeHeader(cbg.MajUnsignedInt, s.Id); err != nil {
+		return err
+	}
+	if err := writeString("lbl"); err != nil {
+		return err
+	}
+	if err := writeString(s.Label); err != nil {
+		return err
+	}
+	if err := writeString("Value"); err != nil {
+		return err
+	}
+	return cw.WriteMajorTypeHeader(cbg.MajUnsignedInt, s.Value)
+}
+
+// Encoding the decoded JSON must reproduce the go-state-types CBOR bytes
+func TestEncodeRoundTrip(t *testing.T) {
+	pieceCid, err := abi.CidBuilder.Sum([]byte("piece"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stringLabel, err := market.NewLabelFromString("label")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bytesLabel, err := market.NewLabelFromBytes([]byte{0xff, 0x00})
+	if err != nil {
+		t.Fatal(err)
+	}
+	huge, err := big.FromString("-123456789012345678901234567890123456789")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newDeal := func(label market.DealLabel) *market.PublishStorageDealsParams {
+		return &market.PublishStorageDealsParams{Deals: []market.ClientDealProposal{{
+			Proposal: market.DealProposal{
+				PieceCID:             pieceCid,
+				PieceSize:            2048,
+				VerifiedDeal:         true,
+				Client:               mustIDAddress(t, 1000),
+				Provider:             mustIDAddress(t, 2000),
+				Label:                label,
+				StartEpoch:           100,
+				EndEpoch:             200,
+				StoragePricePerEpoch: big.NewInt(10),
+				ProviderCollateral:   big.Zero(),
+				ClientCollateral:     big.NewInt(1),
+			},
+			ClientSignature: crypto.Signature{Type: crypto.SigTypeSecp256k1, Data: []byte{1, 2, 3}},
+		}}}
+	}
+
+	tests := []struct {
+		name  string
+		value cbg.CBORMarshaler
+	}{
+		{"ChangeWorkerAddress", &miner.ChangeWorkerAddressParams{
+			NewWorker:       mustIDAddress(t, 1234),
+			NewControlAddrs: []address.Address{mustIDAddress(t, 100), mustIDAddress(t, 101)},
+		}},
+		{"DeclareFaultsRecovered", &miner.DeclareFaultsRecoveredParams{
+			Recoveries: []miner.RecoveryDeclaration{{Deadline: 1, Partition: 2, Sectors: bitfield.NewFromSet([]uint64{1, 2, 3, 100})}},
+		}},
+		{"ExtendSectorExpiration2", &miner.ExtendSectorExpiration2Params{
+			Extensions: []miner.ExpirationExtension2{{
+				Deadline:  3,
+				Partition: 0,
+				Sectors:   bitfield.NewFromSet([]uint64{5}),
+				SectorsWithClaims: []miner.SectorClaim{{
+					SectorNumber:   7,
+					MaintainClaims: []verifreg.ClaimId{1, 2},
+					DropClaims:     []verifreg.ClaimId{},
+				}},
+				NewExpiration: 1000000,
+			}},
+		}},
+		{"TerminateSectors", &miner.TerminateSectorsParams{
+			Terminations: []miner.TerminationDeclaration{{Deadline: 47, Partition: 1, Sectors: bitfield.NewFromSet([]uint64{0, 1 << 40})}},
+		}},
+		{"ProposeNegative", &multisig.ProposeParams{To: mustIDAddress(t, 5), Value: big.NewInt(-1), Method: 2, Params: []byte{0x80}}},
+		{"ProposeHuge", &multisig.ProposeParams{To: mustIDAddress(t, 5), Value: huge, Method: 0, Params: nil}},
+		{"PublishStorageDealsString", newDeal(stringLabel)},
+		{"PublishStorageDealsBytes", newDeal(bytesLabel)},
+		{"EvmConstructor", &evm.ConstructorParams{Creator: [20]byte{1, 2, 3}, Initcode: []byte{0x60, 0x80}}},
+		{"MapRepresentation", &testMapStruct{Value: 1 << 20, Id: 7, Label: "label"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := marshalTestValue(t, test.value)
+			dataType, definitions := getTestDataType(test.value)
+
+			decoded, err := DecodeDataType(dataType, definitions, data)
+			if err != nil {
+				t.Fatalf("failed to decode: %v", err)
+			}
+			decodedJson, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatalf("failed to marshal JSON: %v", err)
+			}
+			encoded, err := EncodeDataType(dataType, definitions, decodedJson)
+			if err != nil {
+				t.Fatalf("failed to encode %s: %v", decodedJson, err)
+			}
+			if !bytes.Equal(encoded, data) {
+				t.Errorf("encoded %x, expected %x for %s", encoded, data, decodedJson)
+			}
+		})
+	}
+}
+
+// cbor-gen writes string keyed maps in sort.Strings order
+func TestEncodeMapKeyOrder(t *testing.T) {
+	dataType := DataType{
+		Type:     TypeMap,
+		Key:      &DataType{Type: TypeString},
+		Contains: &DataType{Type: TypeNumber},
+	}
+	encoded, err := EncodeDataType(dataType, DataTypeDefinitions{}, []byte(`{"bb":1,"a":2,"c":3}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{0xa3, 0x61, 'a', 0x02, 0x62, 'b', 'b', 0x01, 0x61, 'c', 0x03}
+	if !bytes.Equal(encoded, expected) {
+		t.Errorf("encoded %x, expected %x", encoded, expected)
+	}
+}
@@ -0,0 +1,104 @@
+package descriptors
+
+import (
+	"context"
+	"sync"
+
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+)
+
+type LotusEndpoint struct {
+	URL     string
+	Name    dtypes.NetworkName // Overrides the network name reported by Lotus
+	Options LotusOptions
+	History bool // Also retrieve the actor codes of past upgrades
+}
+
+// Actor codes of the network behind a Lotus endpoint
+type NetworkActorCodes struct {
+	Endpoint   string
+	Network    dtypes.NetworkName
+	Manifest   NetworkManifest
+	VersionErr error                  // Set if the network version has no actors version
+	EthErr     error                  // Set if the Eth chain ID could not be retrieved
+	History    []HistoricalActorCodes // Set if requested by the endpoint
+	HistoryErr error
+	Err        error // Set if the actor codes could not be retrieved
+}
+
+// Opens a Lotus JSON-RPC client for the endpoint
+func OpenLotusEndpoint(endpoint LotusEndpoint) (*Lotus, error) {
+	var lotus Lotus
+	if err := lotus.Open(endpoint.URL, endpoint.Options); err != nil {
+		return nil, err
+	}
+	return &lotus, nil
+}
+
+// Retrieves the actor codes from each endpoint concurrently, returning the
+// results in the order of the endpoints. Failures are reported per
+// endpoint, so that one unreachable network does not fail the others.
+// Endpoints are opened with open, such as OpenLotusEndpoint.
+func FetchNetworkActorCodes(ctx context.Context, endpoints []LotusEndpoint, open func(LotusEndpoint) (*Lotus, error)) []NetworkActorCodes {
+	results := make([]NetworkActorCodes, len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint LotusEndpoint) {
+			defer wg.Done()
+			results[i] = fetchNetworkActorCodes(ctx, endpoint, open)
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func fetchNetworkActorCodes(ctx context.Context, endpoint LotusEndpoint, open func(LotusEndpoint) (*Lotus, error)) NetworkActorCodes {
+	result := NetworkActorCodes{Endpoint: endpoint.URL}
+
+	// Each network has its own context, cancelled once it is done
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Open Lotus API for network
+	lotus, err := open(endpoint)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer lotus.Close()
+	if endpoint.Name != "" {
+		lotus.SetNetworkName(endpoint.Name)
+	}
+
+	// Retrieve network name from Lotus
+	if result.Network, result.Err = lotus.GetNetworkName(ctx); result.Err != nil {
+		return result
+	}
+	if endpoint.Name != "" {
+		result.Network = endpoint.Name
+	}
+
+	// Retrieve actor codes and their manifest from Lotus
+	if result.Manifest, result.Err = lotus.GetNetworkManifest(ctx); result.Err != nil {
+		return result
+	}
+	_, result.VersionErr = actorstypes.VersionForNetwork(result.Manifest.NetworkVersion)
+
+	// Retrieve Eth chain ID from Lotus, which is optional
+	if ethChainId, err := lotus.GetEthChainId(ctx); err != nil {
+		result.EthErr = err
+	} else {
+		result.Manifest.EthChainId = &ethChainId
+	}
+
+	// Retrieve actor codes of past upgrades from Lotus
+	if endpoint.History {
+		result.History, result.HistoryErr = lotus.GetActorCodeHistory(ctx)
+	}
+
+	return result
+}
@@ -0,0 +1,142 @@
+package descriptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+// First actors version with a manifest in the system actor state
+const firstManifestActorsVersion = 8
+
+// Returns the actor codes of each upgrade of the network since genesis, up
+// to the pinned tipset or head. The manifest is read from the system actor
+// at a tipset after each upgrade epoch in the network parameters, so that
+// upgrades that did not change the builtin actors are merged. Upgrades whose
+// state cannot be read are left out, returning the remaining history with
+// their errors.
+func (l *Lotus) GetActorCodeHistory(ctx context.Context) ([]HistoricalActorCodes, error) {
+
+	// Retrieve pinned tipset or head
+	head, err := l.getTipSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve upgrade epochs
+	var params *api.NetworkParams
+	err = l.call(ctx, "StateGetNetworkParams", func(ctx context.Context) (err error) {
+		params, err = l.api.StateGetNetworkParams(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	epochs := getUpgradeEpochs(params.ForkUpgradeParams, head.Height())
+
+	var history []HistoricalActorCodes
+	var errs []error
+	var gap bool // Set after an unread upgrade, which ends the previous codes
+	for _, epoch := range epochs {
+		historical, err := l.getHistoricalActorCodes(ctx, epoch, head.Key())
+		if err != nil {
+			if ctx.Err() != nil {
+				return history, ctx.Err()
+			}
+			errs = append(errs, fmt.Errorf("upgrade at epoch %d: %w", epoch, err))
+			if len(history) > 0 && !gap {
+				toEpoch := epoch
+				history[len(history)-1].ToEpoch = &toEpoch
+			}
+			gap = true
+			continue
+		}
+
+		// Actors before version 8 have no manifest
+		if historical.Version < firstManifestActorsVersion {
+			continue
+		}
+
+		// Merge upgrades that kept the builtin actors
+		if len(history) > 0 && !gap && history[len(history)-1].ManifestCid == historical.ManifestCid {
+			continue
+		}
+		if len(history) > 0 && !gap {
+			toEpoch := epoch
+			history[len(history)-1].ToEpoch = &toEpoch
+		}
+		history = append(history, historical)
+		gap = false
+	}
+
+	return history, errors.Join(errs...)
+}
+
+// Reads the actor codes after the migration of the upgrade at epoch, or at
+// genesis
+func (l *Lotus) getHistoricalActorCodes(ctx context.Context, epoch abi.ChainEpoch, head types.TipSetKey) (HistoricalActorCodes, error) {
+
+	// The migration runs at the upgrade epoch, so its state is only read
+	// at the following tipset
+	readEpoch := epoch
+	if epoch > 0 {
+		readEpoch = epoch + 1
+	}
+	var ts *types.TipSet
+	err := l.call(ctx, "ChainGetTipSetAfterHeight", func(ctx context.Context) (err error) {
+		ts, err = l.api.ChainGetTipSetAfterHeight(ctx, readEpoch, head)
+		return err
+	})
+	if err != nil {
+		return HistoricalActorCodes{}, err
+	}
+
+	version, err := l.getActorsVersionAt(ctx, ts.Key())
+	if err != nil || version < firstManifestActorsVersion {
+		return HistoricalActorCodes{Version: version}, err
+	}
+
+	manifestCid, actorCodeMap, err := l.getActorCodeMapAt(ctx, ts.Key())
+	if err != nil {
+		return HistoricalActorCodes{}, err
+	}
+
+	return HistoricalActorCodes{
+		Version:      version,
+		FromEpoch:    epoch,
+		ManifestCid:  manifestCid.String(),
+		ActorCodeMap: actorCodeMap,
+	}, nil
+}
+
+// Returns genesis and the distinct epochs of past upgrades in ascending
+// order. Upgrades at negative epochs are active from genesis, and upgrades
+// at the head are left out until their migrated state is on chain.
+func getUpgradeEpochs(params api.ForkUpgradeParams, head abi.ChainEpoch) []abi.ChainEpoch {
+	var epochs = []abi.ChainEpoch{0}
+	var seen = map[abi.ChainEpoch]bool{0: true}
+
+	v := reflect.ValueOf(params)
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		if !strings.HasPrefix(name, "Upgrade") || !strings.HasSuffix(name, "Height") {
+			continue
+		}
+		epoch := abi.ChainEpoch(v.Field(i).Int())
+		if epoch <= 0 || epoch >= head || seen[epoch] {
+			continue
+		}
+		seen[epoch] = true
+		epochs = append(epochs, epoch)
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+
+	return epochs
+}
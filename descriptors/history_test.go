@@ -0,0 +1,126 @@
+package descriptors
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/builtin"
+	systemActor "github.com/filecoin-project/go-state-types/builtin/v8/system"
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/build"
+	"github.com/ipfs/go-cid"
+)
+
+// Returns a fake that upgrades to actors v8, v9 and v10 at epochs 100, 200
+// and 300, with an upgrade at 250 that keeps the v9 actors
+func newTestHistoryFake(t *testing.T, head abi.ChainEpoch) *FakeLotusAPI {
+	t.Helper()
+	fake := &FakeLotusAPI{
+		NetworkName: "mainnet",
+		Height:      head,
+		Objects:     map[cid.Cid][]byte{},
+		NetworkParams: &api.NetworkParams{
+			NetworkName: "mainnet",
+			ForkUpgradeParams: api.ForkUpgradeParams{
+				UpgradeSmokeHeight:  -1,
+				UpgradeSkyrHeight:   100,
+				UpgradeSharkHeight:  200,
+				UpgradeOhSnapHeight: 250,
+				UpgradeHyggeHeight:  300,
+			},
+		},
+		Upgrades: []fakeUpgrade{{Height: 0, NetworkVersion: network.Version15}},
+	}
+
+	upgrades := []struct {
+		height         abi.ChainEpoch
+		networkVersion network.Version
+		version        actorstypes.Version
+	}{
+		{100, network.Version16, actorstypes.Version8},
+		{200, network.Version17, actorstypes.Version9},
+		{250, network.Version17, actorstypes.Version9},
+		{300, network.Version18, actorstypes.Version10},
+	}
+	for _, upgrade := range upgrades {
+		car, ok := build.GetEmbeddedBuiltinActorsBundle(upgrade.version)
+		if !ok {
+			t.Fatalf("missing embedded v%d bundle", upgrade.version)
+		}
+		bundle, err := NewFakeLotusAPIFromBundle("mainnet", upgrade.networkVersion, bytes.NewReader(car))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for c, object := range bundle.Objects {
+			fake.Objects[c] = object
+		}
+		fake.Upgrades = append(fake.Upgrades, fakeUpgrade{
+			Height:         upgrade.height,
+			NetworkVersion: upgrade.networkVersion,
+			BuiltinActors:  bundle.States[builtin.SystemActorAddr].State.(systemActor.State).BuiltinActors,
+		})
+	}
+
+	return fake
+}
+
+// Version and epochs of historical actor codes, with -1 for no end
+type testHistoryEntry struct {
+	Version   actorstypes.Version
+	FromEpoch abi.ChainEpoch
+	ToEpoch   abi.ChainEpoch
+}
+
+func TestGetActorCodeHistory(t *testing.T) {
+	tests := []struct {
+		name     string
+		head     abi.ChainEpoch
+		failing  []abi.ChainEpoch
+		expected []testHistoryEntry
+		fails    bool
+	}{
+		{"Complete", 400, nil, []testHistoryEntry{{8, 100, 200}, {9, 200, 300}, {10, 300, -1}}, false},
+		{"UpgradeAtHead", 300, nil, []testHistoryEntry{{8, 100, 200}, {9, 200, -1}}, false},
+		{"FailedRead", 400, []abi.ChainEpoch{201}, []testHistoryEntry{{8, 100, 200}, {9, 250, 300}, {10, 300, -1}}, true},
+		{"FailedLastRead", 400, []abi.ChainEpoch{301}, []testHistoryEntry{{8, 100, 200}, {9, 200, 300}}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := newTestHistoryFake(t, test.head)
+			fake.FailingHeights = map[abi.ChainEpoch]bool{}
+			for _, height := range test.failing {
+				fake.FailingHeights[height] = true
+			}
+
+			history, err := NewLotus(fake, LotusOptions{}).GetActorCodeHistory(context.Background())
+			if test.fails != (err != nil) {
+				t.Errorf("got error %v", err)
+			}
+
+			var entries []testHistoryEntry
+			for _, historical := range history {
+				entry := testHistoryEntry{historical.Version, historical.FromEpoch, -1}
+				if historical.ToEpoch != nil {
+					entry.ToEpoch = *historical.ToEpoch
+				}
+				if len(historical.ActorCodeMap) == 0 {
+					t.Errorf("missing actor codes of v%d", historical.Version)
+				}
+				entries = append(entries, entry)
+			}
+			if len(entries) != len(test.expected) {
+				t.Fatalf("got history %v, expected %v", entries, test.expected)
+			}
+			for i := range entries {
+				if entries[i] != test.expected[i] {
+					t.Errorf("got history %v, expected %v", entries, test.expected)
+					break
+				}
+			}
+		})
+	}
+}
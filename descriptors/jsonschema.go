@@ -0,0 +1,225 @@
+package descriptors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/iancoleman/orderedmap"
+)
+
+const JsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// Patterns for values encoded as strings
+const (
+	AddressPattern        = `^[ft](0[0-9]+|[1-3][a-z2-7]+|4[0-9]+f[a-z2-7]+)$`
+	FilecoinNumberPattern = `^-?[0-9]+$`
+	IntegerKeyPattern     = `^-?[0-9]+$`
+)
+
+type JsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	ContentEncoding      string                 `json:"contentEncoding,omitempty"`
+	Minimum              *int                   `json:"minimum,omitempty"`
+	Enum                 []int64                `json:"enum,omitempty"`
+	UniqueItems          bool                   `json:"uniqueItems,omitempty"`
+	Items                *JsonSchema            `json:"items,omitempty"`
+	Properties           *orderedmap.OrderedMap `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	PropertyNames        *JsonSchema            `json:"propertyNames,omitempty"`
+	AdditionalProperties interface{}            `json:"additionalProperties,omitempty"`
+	AnyOf                []*JsonSchema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*JsonSchema `json:"$defs,omitempty"`
+}
+
+// Returns a standalone JSON Schema document for the DataType, which
+// includes the definitions of all referenced types in $defs.
+func GetJsonSchema(dataType DataType, definitions DataTypeDefinitions, title string) (*JsonSchema, error) {
+	builder := jsonSchemaBuilder{
+		definitions: definitions,
+		defs:        map[string]*JsonSchema{},
+	}
+
+	schema, err := builder.getSchema(dataType)
+	if err != nil {
+		return nil, err
+	}
+
+	schema.Schema = JsonSchemaDraft
+	schema.Title = title
+	if len(builder.defs) > 0 {
+		schema.Defs = builder.defs
+	}
+
+	return schema, nil
+}
+
+type jsonSchemaBuilder struct {
+	definitions DataTypeDefinitions
+	defs        map[string]*JsonSchema
+}
+
+func (b *jsonSchemaBuilder) getSchema(dataType DataType) (*JsonSchema, error) {
+	schema, err := b.getNonNullSchema(dataType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nil pointers are represented as null
+	if dataType.Nullable && schema.Type != "null" {
+		return &JsonSchema{AnyOf: []*JsonSchema{schema, {Type: "null"}}}, nil
+	}
+
+	return schema, nil
+}
+
+func (b *jsonSchemaBuilder) getNonNullSchema(dataType DataType) (*JsonSchema, error) {
+
+	// Add referenced definitions to $defs
+	if dataType.Type == TypeRef {
+		key := dataType.Ref
+		if _, ok := b.defs[key]; !ok {
+			definition, err := ResolveDataType(dataType, b.definitions)
+			if err != nil {
+				return nil, err
+			}
+
+			// Reserve the entry first, so that cyclic references terminate
+			b.defs[key] = &JsonSchema{}
+			defSchema, err := b.getNonNullSchema(definition)
+			if err != nil {
+				return nil, err
+			}
+			defSchema.Title = definition.Name
+			b.defs[key] = defSchema
+		}
+
+		// Type IDs contain slashes, which JSON pointers escape
+		pointer := strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+		return &JsonSchema{Ref: "#/$defs/" + pointer}, nil
+	}
+
+	// Handle types with custom encoding
+	switch dataType.Semantic {
+
+	case SemanticAddress:
+		return &JsonSchema{Type: "string", Pattern: AddressPattern}, nil
+
+	case SemanticBigInt, SemanticTokenAmount, SemanticStoragePower, SemanticDealWeight, SemanticSectorQuality:
+		return &JsonSchema{Type: "string", Pattern: FilecoinNumberPattern}, nil
+
+	case SemanticBitField:
+		minimum := 0
+		return &JsonSchema{
+			Type:        "array",
+			Items:       &JsonSchema{Type: "integer", Minimum: &minimum},
+			UniqueItems: true,
+		}, nil
+
+	case SemanticDealLabel:
+		properties := orderedmap.New()
+		properties.Set("Bytes", getBytesSchema())
+		return &JsonSchema{AnyOf: []*JsonSchema{
+			{Type: "string"},
+			{Type: "object", Properties: properties, Required: []string{"Bytes"}, AdditionalProperties: false},
+		}}, nil
+	}
+
+	// Handle base types
+	switch dataType.Type {
+
+	case TypeBool:
+		return &JsonSchema{Type: "boolean"}, nil
+
+	case TypeNumber:
+		schema := &JsonSchema{Type: "integer"}
+		if !dataType.EnumOpen {
+			for _, value := range dataType.Enum {
+				schema.Enum = append(schema.Enum, value.Value)
+			}
+		}
+		return schema, nil
+
+	case TypeString:
+		return &JsonSchema{Type: "string"}, nil
+
+	case TypeBytes:
+		return getBytesSchema(), nil
+
+	case TypeArray:
+		items, err := b.getSchema(*dataType.Contains)
+		if err != nil {
+			return nil, err
+		}
+		return &JsonSchema{Type: "array", Items: items}, nil
+
+	case TypeMap:
+		values, err := b.getSchema(*dataType.Contains)
+		if err != nil {
+			return nil, err
+		}
+		schema := &JsonSchema{Type: "object", AdditionalProperties: values}
+		if dataType.Key.Type == TypeNumber {
+			schema.PropertyNames = &JsonSchema{Pattern: IntegerKeyPattern}
+		}
+		return schema, nil
+
+	case TypeObject:
+		switch dataType.Representation {
+
+		case ReprEmpty:
+			return &JsonSchema{Type: "null"}, nil
+
+		case ReprLink:
+			properties := orderedmap.New()
+			properties.Set("/", &JsonSchema{Type: "string"})
+			return &JsonSchema{Type: "object", Properties: properties, Required: []string{"/"}, AdditionalProperties: false}, nil
+		}
+
+		// Objects contain their encoded fields, all of which are required
+		fields, err := getCborFields(dataType)
+		if err != nil {
+			return nil, err
+		}
+		if dataType.Representation == ReprCustom {
+			if fields, err = getAllFields(dataType); err != nil {
+				return nil, err
+			}
+		}
+		properties := orderedmap.New()
+		properties.SetEscapeHTML(false)
+		required := []string{}
+		for _, field := range fields {
+			fieldSchema, err := b.getSchema(field.DataType)
+			if err != nil {
+				return nil, err
+			}
+			properties.Set(field.Name, fieldSchema)
+			required = append(required, field.Name)
+		}
+		return &JsonSchema{Type: "object", Properties: properties, Required: required, AdditionalProperties: false}, nil
+	}
+
+	return nil, fmt.Errorf("cannot create JSON schema for %s of type %s", dataType.Name, dataType.Type)
+}
+
+// Returns all fields of an object, including those not encoded in CBOR
+func getAllFields(dataType DataType) ([]cborField, error) {
+	var fields []cborField
+	for _, name := range dataType.Children.Keys() {
+		childType, err := GetDataTypeMapEntry(dataType.Children, name)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, cborField{Name: name, DataType: childType})
+	}
+	return fields, nil
+}
+
+// Bytes are represented as base64 strings, matching encoding/json
+func getBytesSchema() *JsonSchema {
+	return &JsonSchema{Type: "string", ContentEncoding: "base64"}
+}
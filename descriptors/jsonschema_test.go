@@ -0,0 +1,81 @@
+package descriptors
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/filecoin-project/go-state-types/builtin"
+)
+
+func TestJsonSchemaRefsResolve(t *testing.T) {
+	definitions := DataTypeDefinitions{}
+	actors := ReflectableActorMap{"storageminer": ReflectableActors[11]["storageminer"]}
+	actorDescriptorMap, err := GetActorDescriptorMap(actors, definitions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	method := actorDescriptorMap["storageminer"].Methods[builtin.MethodsMiner.ChangeWorkerAddress]
+
+	// Method params are not nullable, unlike pointer fields
+	if method.Param.Nullable {
+		t.Error("method param is nullable")
+	}
+	schema, err := GetJsonSchema(method.Param, definitions, method.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema.AnyOf != nil {
+		t.Errorf("method param schema accepts null")
+	}
+
+	// Resolve every $ref as a JSON pointer into the document
+	schemaJson, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var document map[string]interface{}
+	if err := json.Unmarshal(schemaJson, &document); err != nil {
+		t.Fatal(err)
+	}
+	var refs int
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			if ref, ok := value["$ref"].(string); ok {
+				refs++
+				if resolveJsonPointer(document, ref) == nil {
+					t.Errorf("unresolved $ref %s", ref)
+				}
+			}
+			for _, child := range value {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range value {
+				walk(child)
+			}
+		}
+	}
+	walk(document)
+	if refs == 0 {
+		t.Error("expected $refs in schema")
+	}
+}
+
+// Resolves a "#/..." JSON pointer, returning nil if it is missing
+func resolveJsonPointer(document map[string]interface{}, pointer string) interface{} {
+	var value interface{} = document
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "#/"), "/") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		if value, ok = object[token]; !ok {
+			return nil
+		}
+	}
+	return value
+}
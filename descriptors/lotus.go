@@ -0,0 +1,351 @@
+package descriptors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/builtin"
+	systemActor "github.com/filecoin-project/go-state-types/builtin/v8/system"
+	"github.com/filecoin-project/go-state-types/manifest"
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/chain/types/ethtypes"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"github.com/ipfs/go-cid"
+)
+
+// The Lotus API calls used by the descriptors
+type LotusAPI interface {
+	StateNetworkName(ctx context.Context) (dtypes.NetworkName, error)
+	StateNetworkVersion(ctx context.Context, tsk types.TipSetKey) (network.Version, error)
+	StateReadState(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*api.ActorState, error)
+	ChainReadObj(ctx context.Context, c cid.Cid) ([]byte, error)
+	StateGetNetworkParams(ctx context.Context) (*api.NetworkParams, error)
+	ChainHead(ctx context.Context) (*types.TipSet, error)
+	ChainGetTipSet(ctx context.Context, tsk types.TipSetKey) (*types.TipSet, error)
+	ChainGetTipSetAfterHeight(ctx context.Context, epoch abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error)
+	ChainGetGenesis(ctx context.Context) (*types.TipSet, error)
+	EthChainId(ctx context.Context) (ethtypes.EthUint64, error)
+	StateLookupID(ctx context.Context, addr address.Address, tsk types.TipSetKey) (address.Address, error)
+	StateGetActor(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*types.Actor, error)
+}
+
+type LotusOptions struct {
+	Token   string          // Bearer token sent with every request
+	Timeout time.Duration   // Deadline of each call, zero for none
+	Retries int             // Retries of calls that fail with a transient error
+	Backoff time.Duration   // Delay before the first retry, doubled for each next one
+	TipSet  types.TipSetKey // Tipset to read state at, the head if empty
+}
+
+// Returned when a node reports no genesis tipset
+var ErrNoGenesis = errors.New("no genesis tipset")
+
+var DefaultLotusOptions = LotusOptions{
+	Timeout: 30 * time.Second,
+	Retries: 3,
+	Backoff: time.Second,
+}
+
+type Lotus struct {
+	api       LotusAPI
+	rpcCloser jsonrpc.ClientCloser
+	options   LotusOptions
+	name      string             // Network name, or endpoint until it is known
+	network   dtypes.NetworkName // Configured network name, replacing the reported one
+}
+
+// Returns a Lotus for an API implementation, such as an in-memory fake
+func NewLotus(lotusApi LotusAPI, options LotusOptions) *Lotus {
+	return &Lotus{api: lotusApi, options: options, name: "lotus"}
+}
+
+func (l *Lotus) Open(endpoint string, options LotusOptions) error {
+	l.options = options
+	l.name = endpoint
+
+	header := http.Header{}
+	if options.Token != "" {
+		header.Set("Authorization", "Bearer "+options.Token)
+	}
+
+	var fullNode api.FullNodeStruct
+	var err error
+	l.rpcCloser, err = jsonrpc.NewMergeClient(context.Background(),
+		endpoint,
+		"Filecoin",
+		api.GetInternalStructs(&fullNode),
+		header)
+	if err != nil {
+		return fmt.Errorf("%s: failed to connect: %w", endpoint, err)
+	}
+	l.api = &fullNode
+	return nil
+}
+
+func (l *Lotus) Close() {
+	if l.rpcCloser != nil {
+		l.rpcCloser()
+	}
+}
+
+func (l *Lotus) GetNetworkName(ctx context.Context) (dtypes.NetworkName, error) {
+	var networkName dtypes.NetworkName
+	err := l.call(ctx, "StateNetworkName", func(ctx context.Context) (err error) {
+		networkName, err = l.api.StateNetworkName(ctx)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Name errors of further calls by network, unless configured
+	if l.network == "" {
+		l.name = string(networkName)
+	}
+	return networkName, nil
+}
+
+// Names errors by a configured network name rather than the endpoint or
+// the name reported by Lotus
+func (l *Lotus) SetNetworkName(networkName dtypes.NetworkName) {
+	l.network = networkName
+	l.name = string(networkName)
+}
+
+// Reads the actor codes with their manifest and network at the pinned
+// tipset or head. The actors version is zero if the network version has
+// none in go-state-types.
+func (l *Lotus) GetNetworkManifest(ctx context.Context) (NetworkManifest, error) {
+	ts, err := l.getTipSet(ctx)
+	if err != nil {
+		return NetworkManifest{}, err
+	}
+
+	// Read codes and versions at the same tipset
+	manifestCid, actorCodeMap, err := l.getActorCodeMapAt(ctx, ts.Key())
+	if err != nil {
+		return NetworkManifest{}, err
+	}
+	networkVersion, err := l.getNetworkVersionAt(ctx, ts.Key())
+	if err != nil {
+		return NetworkManifest{}, err
+	}
+	version, _ := actorstypes.VersionForNetwork(networkVersion)
+
+	// Identify the chain beyond its name
+	var genesis *types.TipSet
+	err = l.call(ctx, "ChainGetGenesis", func(ctx context.Context) (err error) {
+		genesis, err = l.api.ChainGetGenesis(ctx)
+		return err
+	})
+	if err != nil {
+		return NetworkManifest{}, err
+	}
+	if genesis == nil || len(genesis.Cids()) == 0 {
+		return NetworkManifest{}, fmt.Errorf("%s: %w", l.name, ErrNoGenesis)
+	}
+
+	return NetworkManifest{
+		ManifestCid:    manifestCid.String(),
+		Version:        version,
+		NetworkVersion: networkVersion,
+		Height:         ts.Height(),
+		GenesisCid:     genesis.Cids()[0].String(),
+		ActorCodeMap:   actorCodeMap,
+	}, nil
+}
+
+// Reads the EIP-155 chain ID, which fails on nodes with the Eth RPC
+// disabled
+func (l *Lotus) GetEthChainId(ctx context.Context) (uint64, error) {
+	var ethChainId ethtypes.EthUint64
+	err := l.call(ctx, "EthChainId", func(ctx context.Context) (err error) {
+		ethChainId, err = l.api.EthChainId(ctx)
+		return err
+	})
+	return uint64(ethChainId), err
+}
+
+func (l *Lotus) GetActorCodeMap(ctx context.Context) (ActorCodeMap, error) {
+	_, actorCodeMap, err := l.getActorCodeMapAt(ctx, l.options.TipSet)
+	return actorCodeMap, err
+}
+
+// Reads the manifest of the builtin actors from the system actor state
+func (l *Lotus) getActorCodeMapAt(ctx context.Context, tsk types.TipSetKey) (cid.Cid, ActorCodeMap, error) {
+	var actor *api.ActorState
+	err := l.call(ctx, "StateReadState", func(ctx context.Context) (err error) {
+		actor, err = l.api.StateReadState(ctx, builtin.SystemActorAddr, tsk)
+		return err
+	})
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+
+	var state systemActor.State
+	err = MapToInterface(actor.State, &state)
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+
+	var object []byte
+	err = l.call(ctx, "ChainReadObj", func(ctx context.Context) (err error) {
+		object, err = l.api.ChainReadObj(ctx, state.BuiltinActors)
+		return err
+	})
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+
+	var data manifest.ManifestData
+	err = data.UnmarshalCBOR(bytes.NewReader(object))
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+
+	var actorCodeMap = ActorCodeMap{}
+	for _, entry := range data.Entries {
+		actorCodeMap[entry.Name] = entry.Code.String()
+	}
+
+	return state.BuiltinActors, actorCodeMap, nil
+}
+
+func (l *Lotus) GetActorsVersion(ctx context.Context) (ActorsVersion, error) {
+	return l.getActorsVersionAt(ctx, l.options.TipSet)
+}
+
+func (l *Lotus) getActorsVersionAt(ctx context.Context, tsk types.TipSetKey) (ActorsVersion, error) {
+	networkVersion, err := l.getNetworkVersionAt(ctx, tsk)
+	if err != nil {
+		return 0, err
+	}
+
+	return actorstypes.VersionForNetwork(networkVersion)
+}
+
+func (l *Lotus) getNetworkVersionAt(ctx context.Context, tsk types.TipSetKey) (network.Version, error) {
+	var networkVersion network.Version
+	err := l.call(ctx, "StateNetworkVersion", func(ctx context.Context) (err error) {
+		networkVersion, err = l.api.StateNetworkVersion(ctx, tsk)
+		return err
+	})
+	return networkVersion, err
+}
+
+// Returns the pinned tipset or head
+func (l *Lotus) getTipSet(ctx context.Context) (*types.TipSet, error) {
+	var ts *types.TipSet
+	err := l.call(ctx, "ChainGetTipSet", func(ctx context.Context) (err error) {
+		if l.options.TipSet == types.EmptyTSK {
+			ts, err = l.api.ChainHead(ctx)
+		} else {
+			ts, err = l.api.ChainGetTipSet(ctx, l.options.TipSet)
+		}
+		return err
+	})
+	return ts, err
+}
+
+// Returns a block source that reads objects from Lotus, to walk state
+// collections with
+func (l *Lotus) BlockSource() BlockSource {
+	return func(ctx context.Context, c cid.Cid) ([]byte, error) {
+		var object []byte
+		err := l.call(ctx, "ChainReadObj", func(ctx context.Context) (err error) {
+			object, err = l.api.ChainReadObj(ctx, c)
+			return err
+		})
+		return object, err
+	}
+}
+
+// Calls the API with a deadline, retrying transient errors with backoff
+// until the context is done. Errors name the network and call.
+func (l *Lotus) call(ctx context.Context, method string, call func(ctx context.Context) error) error {
+	backoff := l.options.Backoff
+	for attempt := 0; ; attempt++ {
+		callCtx, cancel := ctx, context.CancelFunc(func() {})
+		if l.options.Timeout > 0 {
+			callCtx, cancel = context.WithTimeout(ctx, l.options.Timeout)
+		}
+		err := call(callCtx)
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+		if !isTransientError(err) || attempt >= l.options.Retries {
+			if attempt > 0 {
+				return fmt.Errorf("%s: %s failed after %d attempts: %w", l.name, method, attempt+1, err)
+			}
+			return fmt.Errorf("%s: %s failed: %w", l.name, method, err)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s: %s failed: %w", l.name, method, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// Connection errors, timeouts and server errors may succeed when retried
+func isTransientError(err error) bool {
+	var connectionError *jsonrpc.RPCConnectionError
+	var netError net.Error
+	switch {
+	case errors.As(err, &connectionError), errors.As(err, &netError):
+		return true
+	case errors.Is(err, context.DeadlineExceeded):
+		return true
+	}
+
+	// go-jsonrpc only reports the status of responses it cannot parse
+	return serverErrorPattern.MatchString(err.Error())
+}
+
+// Matches the "http status 502 Bad Gateway unmarshaling response" errors
+// of go-jsonrpc
+var serverErrorPattern = regexp.MustCompile(`\bhttp status 5\d\d\b`)
+
+// Splits a token given as user info off an endpoint, such as
+// https://<token>@api.node.glif.io/rpc/v1
+func ParseEndpoint(endpoint string) (string, string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if u.User == nil {
+		return endpoint, "", nil
+	}
+	token := u.User.Username()
+	u.User = nil
+	return u.String(), token, nil
+}
+
+// Parses the block CIDs of a tipset, the empty key for none
+func ParseTipSetKey(blocks []string) (types.TipSetKey, error) {
+	var cids []cid.Cid
+	for _, str := range blocks {
+		c, err := cid.Decode(str)
+		if err != nil {
+			return types.EmptyTSK, fmt.Errorf("invalid tipset block CID %s: %w", str, err)
+		}
+		cids = append(cids, c)
+	}
+	return types.NewTipSetKey(cids...), nil
+}
@@ -0,0 +1,192 @@
+package descriptors
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/builtin"
+	systemActor "github.com/filecoin-project/go-state-types/builtin/v8/system"
+	"github.com/filecoin-project/go-state-types/manifest"
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/chain/types/ethtypes"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"github.com/ipfs/go-cid"
+)
+
+// In-memory LotusAPI that serves canned actor states and objects, to test
+// the descriptors without a live node
+type FakeLotusAPI struct {
+	NetworkName    dtypes.NetworkName
+	NetworkVersion network.Version
+	States         map[address.Address]*api.ActorState
+	Actors         map[address.Address]*types.Actor // By ID address
+	IDs            map[address.Address]address.Address
+	Objects        map[cid.Cid][]byte
+	Height         abi.ChainEpoch     // Height of the head tipset
+	NetworkParams  *api.NetworkParams // Upgrade schedule, none if nil
+	EthChainID     uint64
+	Upgrades       []fakeUpgrade // Versions by height, NetworkVersion and States if none
+	FailingHeights map[abi.ChainEpoch]bool
+	tipSets        sync.Map // Heights by tipset key
+}
+
+// Network version and builtin actors from the tipset after Height, or from
+// genesis at height zero
+type fakeUpgrade struct {
+	Height         abi.ChainEpoch
+	NetworkVersion network.Version
+	BuiltinActors  cid.Cid // Manifest data, undefined before actors v8
+}
+
+// Returns the upgrade active at the tipset, nil without upgrades
+func (f *FakeLotusAPI) getUpgrade(tsk types.TipSetKey) *fakeUpgrade {
+	height := f.Height
+	if value, ok := f.tipSets.Load(tsk); ok {
+		height = value.(abi.ChainEpoch)
+	}
+	var active *fakeUpgrade
+	for i, upgrade := range f.Upgrades {
+		if upgrade.Height == 0 || upgrade.Height < height {
+			active = &f.Upgrades[i]
+		}
+	}
+	return active
+}
+
+// Returns a FakeLotusAPI for a network running the builtin-actors bundle
+// CAR file, whose manifest is referenced by the system actor state
+func NewFakeLotusAPIFromBundle(networkName dtypes.NetworkName, networkVersion network.Version, r io.Reader) (*FakeLotusAPI, error) {
+	root, blocks, err := readCarBlocks(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The system actor links to the manifest data
+	object, ok := blocks[root]
+	if !ok {
+		return nil, fmt.Errorf("missing manifest %s", root)
+	}
+	var m manifest.Manifest
+	if err := m.UnmarshalCBOR(bytes.NewReader(object)); err != nil {
+		return nil, err
+	}
+
+	return &FakeLotusAPI{
+		NetworkName:    networkName,
+		NetworkVersion: networkVersion,
+		States: map[address.Address]*api.ActorState{
+			builtin.SystemActorAddr: {State: systemActor.State{BuiltinActors: m.Data}},
+		},
+		Objects: blocks,
+	}, nil
+}
+
+func (f *FakeLotusAPI) StateNetworkName(ctx context.Context) (dtypes.NetworkName, error) {
+	return f.NetworkName, nil
+}
+
+func (f *FakeLotusAPI) StateNetworkVersion(ctx context.Context, tsk types.TipSetKey) (network.Version, error) {
+	if upgrade := f.getUpgrade(tsk); upgrade != nil {
+		return upgrade.NetworkVersion, nil
+	}
+	return f.NetworkVersion, nil
+}
+
+func (f *FakeLotusAPI) StateReadState(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*api.ActorState, error) {
+	if upgrade := f.getUpgrade(tsk); upgrade != nil && addr == builtin.SystemActorAddr {
+		return &api.ActorState{State: systemActor.State{BuiltinActors: upgrade.BuiltinActors}}, nil
+	}
+	state, ok := f.States[addr]
+	if !ok {
+		return nil, fmt.Errorf("actor not found: %s", addr)
+	}
+	return state, nil
+}
+
+func (f *FakeLotusAPI) StateLookupID(ctx context.Context, addr address.Address, tsk types.TipSetKey) (address.Address, error) {
+	if addr.Protocol() == address.ID {
+		return addr, nil
+	}
+	id, ok := f.IDs[addr]
+	if !ok {
+		return address.Undef, fmt.Errorf("actor not found: %s", addr)
+	}
+	return id, nil
+}
+
+func (f *FakeLotusAPI) StateGetActor(ctx context.Context, addr address.Address, tsk types.TipSetKey) (*types.Actor, error) {
+	actor, ok := f.Actors[addr]
+	if !ok {
+		return nil, fmt.Errorf("actor not found: %s", addr)
+	}
+	return actor, nil
+}
+
+func (f *FakeLotusAPI) ChainReadObj(ctx context.Context, c cid.Cid) ([]byte, error) {
+	object, ok := f.Objects[c]
+	if !ok {
+		return nil, fmt.Errorf("object not found: %s", c)
+	}
+	return object, nil
+}
+
+func (f *FakeLotusAPI) StateGetNetworkParams(ctx context.Context) (*api.NetworkParams, error) {
+	if f.NetworkParams == nil {
+		return &api.NetworkParams{NetworkName: f.NetworkName}, nil
+	}
+	return f.NetworkParams, nil
+}
+
+func (f *FakeLotusAPI) ChainHead(ctx context.Context) (*types.TipSet, error) {
+	return f.getTipSet(f.Height)
+}
+
+func (f *FakeLotusAPI) ChainGetTipSet(ctx context.Context, tsk types.TipSetKey) (*types.TipSet, error) {
+	return f.getTipSet(f.Height)
+}
+
+func (f *FakeLotusAPI) ChainGetTipSetAfterHeight(ctx context.Context, epoch abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error) {
+	if epoch > f.Height {
+		return nil, fmt.Errorf("epoch %d is after head %d", epoch, f.Height)
+	}
+	if f.FailingHeights[epoch] {
+		return nil, fmt.Errorf("tipset at epoch %d not found", epoch)
+	}
+	return f.getTipSet(epoch)
+}
+
+func (f *FakeLotusAPI) ChainGetGenesis(ctx context.Context) (*types.TipSet, error) {
+	return f.getTipSet(0)
+}
+
+func (f *FakeLotusAPI) EthChainId(ctx context.Context) (ethtypes.EthUint64, error) {
+	return ethtypes.EthUint64(f.EthChainID), nil
+}
+
+// Returns a tipset of a single empty block, remembering its height to
+// serve the state of the upgrade at that height
+func (f *FakeLotusAPI) getTipSet(height abi.ChainEpoch) (*types.TipSet, error) {
+	empty, err := abi.CidBuilder.Sum(nil)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := types.NewTipSet([]*types.BlockHeader{{
+		Miner:                 builtin.SystemActorAddr,
+		Height:                height,
+		ParentStateRoot:       empty,
+		ParentMessageReceipts: empty,
+		Messages:              empty,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	f.tipSets.Store(ts.Key(), height)
+	return ts, nil
+}
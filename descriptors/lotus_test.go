@@ -0,0 +1,252 @@
+package descriptors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/builtin"
+	systemActor "github.com/filecoin-project/go-state-types/builtin/v8/system"
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/filecoin-project/lotus/build"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/chain/types/ethtypes"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+)
+
+// Returns a fake mainnet running the v10 actors embedded in Lotus, with
+// the metadata of that bundle
+func newTestFakeLotusAPI(t *testing.T) (*FakeLotusAPI, *build.BuiltinActorsMetadata) {
+	t.Helper()
+	car, ok := build.GetEmbeddedBuiltinActorsBundle(actorstypes.Version10)
+	if !ok {
+		t.Fatal("missing embedded v10 bundle")
+	}
+	fake, err := NewFakeLotusAPIFromBundle("mainnet", network.Version18, bytes.NewReader(car))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.Height = 1000
+	fake.EthChainID = 314
+	for _, meta := range build.EmbeddedBuiltinActorsMetadata {
+		if meta.Network == "mainnet" && meta.Version == actorstypes.Version10 {
+			return fake, meta
+		}
+	}
+	t.Fatal("missing metadata of mainnet v10 bundle")
+	return nil, nil
+}
+
+func assertActorCodeMap(t *testing.T, actorCodeMap ActorCodeMap, meta *build.BuiltinActorsMetadata) {
+	t.Helper()
+	if len(actorCodeMap) != len(meta.Actors) {
+		t.Errorf("got %d actor codes, expected %d", len(actorCodeMap), len(meta.Actors))
+	}
+	for name, code := range meta.Actors {
+		if actorCodeMap[name] != code.String() {
+			t.Errorf("got code %s for %s, expected %s", actorCodeMap[name], name, code)
+		}
+	}
+}
+
+func TestGetActorCodeMap(t *testing.T) {
+	fake, meta := newTestFakeLotusAPI(t)
+	actorCodeMap, err := NewLotus(fake, LotusOptions{}).GetActorCodeMap(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertActorCodeMap(t, actorCodeMap, meta)
+}
+
+func TestGetNetworkManifest(t *testing.T) {
+	fake, meta := newTestFakeLotusAPI(t)
+	m, err := NewLotus(fake, LotusOptions{}).GetNetworkManifest(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The system actor links to the manifest data, not the bundle root
+	data := fake.States[builtin.SystemActorAddr].State.(systemActor.State).BuiltinActors
+	if m.ManifestCid != data.String() {
+		t.Errorf("got manifest %s, expected %s", m.ManifestCid, data)
+	}
+	if m.Version != actorstypes.Version10 || m.NetworkVersion != network.Version18 {
+		t.Errorf("got versions %d and %d, expected 10 and 18", m.Version, m.NetworkVersion)
+	}
+	if m.Height != fake.Height {
+		t.Errorf("got height %d, expected %d", m.Height, fake.Height)
+	}
+	if m.GenesisCid == "" {
+		t.Error("missing genesis CID")
+	}
+	assertActorCodeMap(t, m.ActorCodeMap, meta)
+}
+
+func TestFetchNetworkActorCodes(t *testing.T) {
+	fake, meta := newTestFakeLotusAPI(t)
+	errUnreachable := errors.New("unreachable")
+	open := func(endpoint LotusEndpoint) (*Lotus, error) {
+		if endpoint.URL == "down" {
+			return nil, errUnreachable
+		}
+		return NewLotus(fake, endpoint.Options), nil
+	}
+
+	results := FetchNetworkActorCodes(context.Background(), []LotusEndpoint{
+		{URL: "up"},
+		{URL: "down"},
+		{URL: "renamed", Name: "local"},
+	}, open)
+	if len(results) != 3 {
+		t.Fatalf("got %d results, expected 3", len(results))
+	}
+
+	// The failing endpoint does not fail the others
+	if !errors.Is(results[1].Err, errUnreachable) {
+		t.Errorf("got error %v for down endpoint", results[1].Err)
+	}
+	for _, i := range []int{0, 2} {
+		result := results[i]
+		if result.Err != nil || result.VersionErr != nil {
+			t.Fatalf("%s: %v, %v", result.Endpoint, result.Err, result.VersionErr)
+		}
+		assertActorCodeMap(t, result.Manifest.ActorCodeMap, meta)
+		if result.EthErr != nil || result.Manifest.EthChainId == nil || *result.Manifest.EthChainId != 314 {
+			t.Errorf("%s: got eth chain id %v, %v", result.Endpoint, result.Manifest.EthChainId, result.EthErr)
+		}
+	}
+	if results[0].Network != "mainnet" || results[2].Network != "local" {
+		t.Errorf("got networks %s and %s", results[0].Network, results[2].Network)
+	}
+}
+
+// Fake that fails the first calls of StateNetworkName with an error
+type flakyLotusAPI struct {
+	*FakeLotusAPI
+	failures int
+	err      error
+	calls    int
+}
+
+func (f *flakyLotusAPI) StateNetworkName(ctx context.Context) (dtypes.NetworkName, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return "", f.err
+	}
+	return f.FakeLotusAPI.StateNetworkName(ctx)
+}
+
+func TestCallRetries(t *testing.T) {
+	fake, _ := newTestFakeLotusAPI(t)
+	transient := &net.DNSError{Err: "timeout", IsTimeout: true, IsTemporary: true}
+	options := LotusOptions{Retries: 3, Backoff: time.Millisecond}
+
+	tests := []struct {
+		name     string
+		failures int
+		err      error
+		calls    int
+		fails    bool
+	}{
+		{"Transient", 2, transient, 3, false},
+		{"ServerError", 1, fmt.Errorf("http status 502 Bad Gateway unmarshaling response: %w", errors.New("eof")), 2, false},
+		{"TooMany", 4, transient, 4, true},
+		{"Permanent", 1, errors.New("http status 404 Not Found"), 1, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flaky := &flakyLotusAPI{FakeLotusAPI: fake, failures: test.failures, err: test.err}
+			name, err := NewLotus(flaky, options).GetNetworkName(context.Background())
+			if flaky.calls != test.calls {
+				t.Errorf("got %d calls, expected %d", flaky.calls, test.calls)
+			}
+			if test.fails {
+				if !errors.Is(err, test.err) {
+					t.Errorf("got error %v, expected %v", err, test.err)
+				}
+			} else if err != nil || name != "mainnet" {
+				t.Errorf("got %s, %v", name, err)
+			}
+		})
+	}
+}
+
+func TestCallCancelledDuringBackoff(t *testing.T) {
+	fake, _ := newTestFakeLotusAPI(t)
+	flaky := &flakyLotusAPI{FakeLotusAPI: fake, failures: 10, err: &net.DNSError{IsTemporary: true}}
+	lotus := NewLotus(flaky, LotusOptions{Retries: 10, Backoff: time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := lotus.GetNetworkName(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, expected deadline exceeded", err)
+	}
+	if flaky.calls != 1 {
+		t.Errorf("got %d calls, expected 1", flaky.calls)
+	}
+}
+
+// Fake of a node with the Eth RPC disabled
+type noEthLotusAPI struct {
+	*FakeLotusAPI
+}
+
+func (f *noEthLotusAPI) EthChainId(ctx context.Context) (ethtypes.EthUint64, error) {
+	return 0, errors.New("module disabled, enable with Fevm.EnableEthRPC / LOTUS_FEVM_ENABLEETHRPC")
+}
+
+func TestFetchNetworkActorCodesWithoutEth(t *testing.T) {
+	fake, meta := newTestFakeLotusAPI(t)
+	open := func(endpoint LotusEndpoint) (*Lotus, error) {
+		return NewLotus(&noEthLotusAPI{fake}, endpoint.Options), nil
+	}
+
+	result := FetchNetworkActorCodes(context.Background(), []LotusEndpoint{{URL: "noeth"}}, open)[0]
+	if result.Err != nil {
+		t.Fatal(result.Err)
+	}
+	if result.EthErr == nil || result.Manifest.EthChainId != nil {
+		t.Errorf("got eth chain id %v, %v", result.Manifest.EthChainId, result.EthErr)
+	}
+	assertActorCodeMap(t, result.Manifest.ActorCodeMap, meta)
+}
+
+// Fake of a node that reports no genesis tipset
+type noGenesisLotusAPI struct {
+	*FakeLotusAPI
+	genesis *types.TipSet
+}
+
+func (f *noGenesisLotusAPI) ChainGetGenesis(ctx context.Context) (*types.TipSet, error) {
+	return f.genesis, nil
+}
+
+func TestGetNetworkManifestWithoutGenesis(t *testing.T) {
+	fake, _ := newTestFakeLotusAPI(t)
+	for _, genesis := range []*types.TipSet{nil, {}} {
+		_, err := NewLotus(&noGenesisLotusAPI{fake, genesis}, LotusOptions{}).GetNetworkManifest(context.Background())
+		if !errors.Is(err, ErrNoGenesis) {
+			t.Errorf("got error %v, expected %v", err, ErrNoGenesis)
+		}
+	}
+}
+
+// Errors are named by the configured network rather than the reported one
+func TestFetchNetworkActorCodesErrorName(t *testing.T) {
+	fake, _ := newTestFakeLotusAPI(t)
+	open := func(endpoint LotusEndpoint) (*Lotus, error) {
+		return NewLotus(&noGenesisLotusAPI{FakeLotusAPI: fake}, endpoint.Options), nil
+	}
+
+	result := FetchNetworkActorCodes(context.Background(), []LotusEndpoint{{URL: "renamed", Name: "local"}}, open)[0]
+	if !errors.Is(result.Err, ErrNoGenesis) || !strings.HasPrefix(result.Err.Error(), "local: ") {
+		t.Errorf("got error %v", result.Err)
+	}
+}
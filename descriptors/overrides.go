@@ -0,0 +1,113 @@
+package descriptors
+
+import (
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-bitfield"
+	"github.com/filecoin-project/go-state-types/abi"
+	gstbig "github.com/filecoin-project/go-state-types/big"
+	"github.com/filecoin-project/go-state-types/crypto"
+	"github.com/filecoin-project/go-state-types/exitcode"
+	"github.com/iancoleman/orderedmap"
+	"github.com/ipfs/go-cid"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+// Maps a type to its DataType instead of reflecting it, such as for types
+// with custom marshalling. An empty Name is set to the type name.
+type TypeMapper func(t reflect.Type, definitions DataTypeDefinitions) DataType
+
+// Semantic of a type and, optionally, the mapper that replaces reflection
+// and the codec of its values, which is registered for the semantic
+type TypeOverride struct {
+	Semantic string
+	Mapper   TypeMapper
+	Codec    *Codec
+}
+
+var typeOverrides = struct {
+	sync.RWMutex
+	types map[reflect.Type]TypeOverride
+}{types: map[reflect.Type]TypeOverride{}}
+
+// Registers the override of a type, replacing any previous one. Register
+// types before building descriptors, such as in an init function.
+func RegisterTypeOverride(t reflect.Type, override TypeOverride) {
+	if override.Codec != nil {
+		RegisterCodec(override.Semantic, *override.Codec)
+	}
+	typeOverrides.Lock()
+	defer typeOverrides.Unlock()
+	typeOverrides.types[t] = override
+}
+
+func getTypeOverride(t reflect.Type) (TypeOverride, bool) {
+	typeOverrides.RLock()
+	defer typeOverrides.RUnlock()
+	override, ok := typeOverrides.types[t]
+	return override, ok
+}
+
+// Aliases such as abi.TokenAmount are the aliased type here, their
+// semantics are in semanticFields instead
+func init() {
+	RegisterTypeOverride(typeOf((*address.Address)(nil)), TypeOverride{Semantic: SemanticAddress, Mapper: mapString, Codec: &addressCodec})
+	RegisterTypeOverride(typeOf((*gstbig.Int)(nil)), TypeOverride{Semantic: SemanticBigInt, Mapper: mapFilecoinNumber, Codec: &bigIntCodec})
+	RegisterTypeOverride(typeOf((*big.Int)(nil)), TypeOverride{Semantic: SemanticBigInt, Mapper: mapString})
+	RegisterTypeOverride(typeOf((*bitfield.BitField)(nil)), TypeOverride{Semantic: SemanticBitField, Mapper: mapBitField, Codec: &bitFieldCodec})
+	RegisterTypeOverride(typeOf((*cid.Cid)(nil)), TypeOverride{Semantic: SemanticCid, Mapper: mapCid})
+	RegisterTypeOverride(typeOf((*cbg.CborCid)(nil)), TypeOverride{Semantic: SemanticCid, Mapper: mapCid})
+
+	for ptr, semantic := range map[interface{}]string{
+		(*abi.ChainEpoch)(nil):                 SemanticChainEpoch,
+		(*abi.ActorID)(nil):                    SemanticActorID,
+		(*abi.MethodNum)(nil):                  SemanticMethodNum,
+		(*abi.SectorNumber)(nil):               SemanticSectorNumber,
+		(*abi.SectorSize)(nil):                 SemanticSectorSize,
+		(*abi.DealID)(nil):                     SemanticDealID,
+		(*abi.PaddedPieceSize)(nil):            SemanticPaddedPieceSize,
+		(*abi.UnpaddedPieceSize)(nil):          SemanticUnpaddedPieceSize,
+		(*abi.Randomness)(nil):                 SemanticRandomness,
+		(*abi.SealRandomness)(nil):             SemanticRandomness,
+		(*abi.InteractiveSealRandomness)(nil):  SemanticRandomness,
+		(*abi.PoStRandomness)(nil):             SemanticRandomness,
+		(*abi.RegisteredSealProof)(nil):        SemanticRegisteredSealProof,
+		(*abi.RegisteredPoStProof)(nil):        SemanticRegisteredPoStProof,
+		(*abi.RegisteredAggregationProof)(nil): SemanticRegisteredAggregationProof,
+		(*abi.RegisteredUpdateProof)(nil):      SemanticRegisteredUpdateProof,
+		(*crypto.SigType)(nil):                 SemanticSigType,
+		(*exitcode.ExitCode)(nil):              SemanticExitCode,
+	} {
+		RegisterTypeOverride(typeOf(ptr), TypeOverride{Semantic: semantic})
+	}
+	RegisterTypeOverride(typeOf((*crypto.Signature)(nil)), TypeOverride{Semantic: SemanticSignature, Codec: &signatureCodec})
+}
+
+func typeOf(ptr interface{}) reflect.Type {
+	return reflect.TypeOf(ptr).Elem()
+}
+
+func mapString(t reflect.Type, definitions DataTypeDefinitions) DataType {
+	return DataType{Type: TypeString}
+}
+
+// go-state-types big.Int marshals as a string in JSON
+func mapFilecoinNumber(t reflect.Type, definitions DataTypeDefinitions) DataType {
+	return DataType{Name: "FilecoinNumber", Type: TypeString}
+}
+
+func mapBitField(t reflect.Type, definitions DataTypeDefinitions) DataType {
+	containsType := DataType{Name: "Bit", Type: TypeNumber}
+	return DataType{Type: TypeArray, Contains: &containsType}
+}
+
+func mapCid(t reflect.Type, definitions DataTypeDefinitions) DataType {
+	dataType := DataType{Type: TypeObject, Representation: ReprLink}
+	dataType.Children = orderedmap.New()
+	dataType.Children.SetEscapeHTML(false)
+	dataType.Children.Set("/", DataType{Name: "CidString", Type: TypeString})
+	return dataType
+}
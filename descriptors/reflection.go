@@ -0,0 +1,365 @@
+package descriptors
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/iancoleman/orderedmap"
+	"github.com/ipfs/go-cid"
+	cbg "github.com/whyrusleeping/cbor-gen"
+)
+
+var cidType = reflect.TypeOf((*cid.Cid)(nil)).Elem()
+
+func GetDataType(t reflect.Type, definitions DataTypeDefinitions) DataType {
+	var dataType DataType
+	dataType.Name = t.Name()
+
+	// Map overridden types instead of reflecting them
+	override, isOverridden := getTypeOverride(t)
+	if semantic, ok := semanticTypes[GetTypeId(t)]; ok && !isOverridden {
+		override, isOverridden = TypeOverride{Semantic: semantic}, true
+	}
+	if isOverridden && override.Mapper != nil {
+		dataType = override.Mapper(t, definitions)
+		if dataType.Name == "" {
+			dataType.Name = t.Name()
+		}
+		if override.Semantic != "" {
+			dataType.Semantic = override.Semantic
+		}
+		return dataType
+	}
+
+	// Store named structs and interfaces once in the definitions,
+	// reserving the entry first so that cyclic references terminate
+	if (t.Kind() == reflect.Struct || t.Kind() == reflect.Interface) && t.Name() != "" {
+		typeId := GetTypeId(t)
+		if _, ok := definitions[typeId]; !ok {
+			definitions[typeId] = DataType{Type: TypeRef, Name: t.Name()}
+			definition := getBaseDataType(t, definitions)
+			definition.Semantic = override.Semantic
+			definitions[typeId] = definition
+		}
+		dataType.Type = TypeRef
+		dataType.Ref = typeId
+		dataType.Semantic = override.Semantic
+		return dataType
+	}
+
+	dataType = getBaseDataType(t, definitions)
+	if isOverridden {
+		dataType.Semantic = override.Semantic
+	}
+	if enum, ok := enumTypes[GetTypeId(t)]; ok {
+		dataType.Enum = enum.Values
+		dataType.EnumOpen = enum.Open
+	}
+	return dataType
+}
+
+func getBaseDataType(t reflect.Type, definitions DataTypeDefinitions) DataType {
+	var dataType DataType
+	dataType.Name = t.Name()
+
+	// Handle base types
+	switch t.Kind() {
+
+	case reflect.Ptr:
+		elemDataType := GetDataType(t.Elem(), definitions)
+		elemDataType.Nullable = true
+		return elemDataType
+
+	case reflect.Bool:
+		dataType.Type = TypeBool
+		return dataType
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		dataType.Type = TypeNumber
+		return dataType
+
+	case reflect.String:
+		dataType.Type = TypeString
+		return dataType
+
+	case reflect.Chan:
+		containsType := GetDataType(t.Elem(), definitions)
+		dataType.Type = TypeChan
+		dataType.ChanDir = t.ChanDir().String()
+		dataType.Contains = &containsType
+		return dataType
+
+	case reflect.Map:
+		keyType := GetDataType(t.Key(), definitions)
+		containsType := GetDataType(t.Elem(), definitions)
+		dataType.Type = TypeMap
+		dataType.Key = &keyType
+		dataType.Contains = &containsType
+		return dataType
+
+	case reflect.Array, reflect.Slice:
+		containsType := GetDataType(t.Elem(), definitions)
+
+		// Treat uint8 arrays as bytes
+		if containsType.Name == "uint8" {
+			dataType.Type = TypeBytes
+			if getRepresentation(t) == ReprEmpty {
+				dataType.Representation = ReprRaw
+			}
+			return dataType
+		}
+
+		dataType.Type = TypeArray
+		dataType.Contains = &containsType
+		return dataType
+
+	case reflect.Struct:
+		dataType.Type = TypeObject
+		dataType.Children = orderedmap.New()
+		dataType.Children.SetEscapeHTML(false)
+		dataType.Representation = getRepresentation(t)
+		for i, index := 0, 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			fieldDataType := GetDataType(f.Type, definitions)
+
+			// Annotate fields declared with an alias
+			if semantic, ok := semanticFields[GetTypeId(t)+"."+f.Name]; ok {
+				setFieldSemantic(&fieldDataType, semantic)
+			}
+
+			// Annotate links to collection roots
+			if field, ok := containerFields[GetTypeId(t)+"."+f.Name]; ok {
+				container := field.getContainer(definitions)
+				fieldDataType.Container = &container
+			}
+
+			// cbor-gen only encodes exported fields
+			if f.IsExported() {
+				switch dataType.Representation {
+				case ReprTuple:
+					cborIndex := index
+					fieldDataType.CborIndex = &cborIndex
+				case ReprMap:
+					fieldDataType.CborKey = getCborKey(f)
+				}
+				index++
+			}
+
+			dataType.Children.Set(f.Name, fieldDataType)
+		}
+		return dataType
+
+	case reflect.Func:
+		dataType.Type = TypeFunction
+		dataType.IsVariadic = t.IsVariadic()
+		for i := 0; i < t.NumIn(); i++ {
+			dataType.Params = append(dataType.Params, GetDataType(t.In(i), definitions))
+		}
+		for i := 0; i < t.NumOut(); i++ {
+			dataType.Returns = append(dataType.Returns, GetDataType(t.Out(i), definitions))
+		}
+		return dataType
+
+	case reflect.Interface:
+		dataType.Type = TypeInterface
+		dataType.Methods = orderedmap.New()
+		dataType.Methods.SetEscapeHTML(false)
+		for i := 0; i < t.NumMethod(); i++ {
+			m := t.Method(i)
+			dataType.Methods.Set(m.Name, GetDataType(m.Type, definitions))
+		}
+		return dataType
+	}
+
+	// Unhandled type
+	panic(fmt.Sprintf("Unhandled type with string: %s, name: %s, kind: %s", t.String(), t.Name(), t.Kind().String()))
+}
+
+// Returns the fully qualified type ID, the package path and name
+func GetTypeId(t reflect.Type) TypeId {
+	if t.PkgPath() == "" {
+		return t.Name()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
+
+// Returns the definition for a DataType that references one
+func ResolveDataType(dataType DataType, definitions DataTypeDefinitions) (DataType, error) {
+	if dataType.Type != TypeRef {
+		return dataType, nil
+	}
+	definition, ok := definitions[dataType.Ref]
+	if !ok {
+		return dataType, fmt.Errorf("missing definition for %s", dataType.Ref)
+	}
+	return definition, nil
+}
+
+// Derives the CBOR representation of a type by marshalling its zero value
+func getRepresentation(t reflect.Type) string {
+	marshaler, ok := reflect.New(t).Interface().(cbg.CBORMarshaler)
+	if !ok {
+
+		// Builtin actors encode all structs as tuples, including
+		// params for which go-state-types has no cbor-gen output
+		if t.Kind() == reflect.Struct {
+			return ReprTuple
+		}
+		return ""
+	}
+
+	// Zero values of some fields fail to marshal, such as address.Undef,
+	// but cbor-gen has written the struct header by then
+	var buf bytes.Buffer
+	if err := marshaler.MarshalCBOR(&buf); buf.Len() == 0 {
+
+		// abi.EmptyValue only marshals as nil pointer
+		nilMarshaler := reflect.Zero(reflect.PtrTo(t)).Interface().(cbg.CBORMarshaler)
+		if err == nil || (nilMarshaler.MarshalCBOR(&buf) == nil && buf.Len() == 0) {
+			return ReprEmpty
+		}
+	}
+
+	// cbor-gen writes a header with the number of exported fields
+	if t.Kind() == reflect.Struct {
+		maj, extra, err := cbg.CborReadHeader(&buf)
+		if err == nil && extra == uint64(getExportedFieldCount(t)) {
+			switch maj {
+			case cbg.MajArray:
+				return ReprTuple
+			case cbg.MajMap:
+				return ReprMap
+			}
+		}
+	}
+
+	return ReprCustom
+}
+
+func getExportedFieldCount(t reflect.Type) int {
+	count := 0
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			count++
+		}
+	}
+	return count
+}
+
+// Returns the map key cbor-gen uses for a field, which can
+// be overridden by a name in the cborgen struct tag
+func getCborKey(f reflect.StructField) string {
+	for _, elem := range strings.Split(f.Tag.Get("cborgen"), ",") {
+		elem = strings.TrimSpace(elem)
+		if elem == "" {
+			continue
+		}
+		if name, ok := strings.CutPrefix(elem, "name="); ok {
+			return strings.TrimSpace(name)
+		}
+		if !strings.Contains(elem, "=") {
+			return elem
+		}
+	}
+	return f.Name
+}
+
+// Returns the actor descriptors of every actors version in the registry
+func GetVersionedActorDescriptorMap(definitions DataTypeDefinitions) (VersionedActorDescriptorMap, error) {
+	var versionedActorDescriptorMap = VersionedActorDescriptorMap{}
+	for version, actors := range ReflectableActors {
+		actorDescriptorMap, err := GetActorDescriptorMap(actors, definitions)
+		if err != nil {
+			return nil, fmt.Errorf("actors version %d: %w", version, err)
+		}
+		versionedActorDescriptorMap[version] = actorDescriptorMap
+	}
+	return versionedActorDescriptorMap, nil
+}
+
+func GetActorDescriptorMap(actors ReflectableActorMap, definitions DataTypeDefinitions) (ActorDescriptorMap, error) {
+	var actorDescriptorMap = ActorDescriptorMap{}
+	for name, reflectableActor := range actors {
+
+		// State reflection
+		var actorState DataTypeMap = nil
+		if stateType := reflect.TypeOf(reflectableActor.State); stateType != nil {
+			stateDataType, err := ResolveDataType(GetDataType(stateType, definitions), definitions)
+			if err != nil {
+				return nil, err
+			}
+			if stateDataType.Type != TypeObject {
+				return nil, fmt.Errorf("%s actor state is not an object", name)
+			}
+			actorState = stateDataType.Children
+		}
+
+		// Methods reflection
+		var actorMethodMap = ActorMethodMap{}
+
+		// Add Send method
+		if name != "system" {
+			emptyType := reflect.TypeOf((*abi.EmptyValue)(nil))
+			emptyDataType := GetDataType(emptyType, definitions)
+			emptyDataType.Nullable = false
+			actorMethodMap[0] = ActorMethod{
+				Name:   "Send",
+				Param:  emptyDataType,
+				Return: emptyDataType,
+			}
+		}
+
+		// Iterate over actor methods
+		for key, method := range reflectableActor.Methods {
+
+			// Skip deprecated methods without signature
+			if method.Method == nil {
+				continue
+			}
+
+			// Get method DataType
+			methodDataType := GetDataType(reflect.TypeOf(method.Method), definitions)
+			if methodDataType.Type != TypeFunction {
+				return nil, fmt.Errorf("%s actor method %s is not a function", name, method.Name)
+			}
+
+			// Set method parameter
+			paramsCount := len(methodDataType.Params)
+			if paramsCount != 1 {
+				return nil, fmt.Errorf("%s actor method %s has %d parameters, expected 1", name, method.Name, paramsCount)
+			}
+
+			// Set method return value
+			returnsCount := len(methodDataType.Returns)
+			if returnsCount != 1 {
+				return nil, fmt.Errorf("%s actor method %s has %d return values, expected 1", name, method.Name, returnsCount)
+			}
+
+			// Params and return values are passed by pointer, which does
+			// not make them nullable, unlike pointer fields
+			param, ret := methodDataType.Params[0], methodDataType.Returns[0]
+			param.Nullable = false
+			ret.Nullable = false
+
+			// Store method in map
+			actorMethodMap[key] = ActorMethod{
+				Name:   method.Name,
+				Param:  param,
+				Return: ret,
+			}
+		}
+
+		// Set actor descriptor
+		actorDescriptorMap[name] = ActorDescriptor{
+			State:   actorState,
+			Methods: actorMethodMap,
+		}
+	}
+
+	return actorDescriptorMap, nil
+}
@@ -0,0 +1,50 @@
+package descriptors
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Struct referencing itself directly and through a slice
+type testNode struct {
+	Value    uint64
+	Next     *testNode
+	Children []testNode
+}
+
+func TestGetDataTypeCycle(t *testing.T) {
+	dataType, definitions := getTestDataType(testNode{})
+	typeId := GetTypeId(reflect.TypeOf(testNode{}))
+	if dataType.Type != TypeRef || dataType.Ref != typeId {
+		t.Fatalf("got %s data type with ref %s", dataType.Type, dataType.Ref)
+	}
+	if len(definitions) != 1 {
+		t.Fatalf("got %d definitions, expected 1", len(definitions))
+	}
+
+	// The reserved entry is replaced by the struct, whose fields refer back
+	definition := definitions[typeId]
+	if definition.Type != TypeObject || definition.Representation != ReprTuple {
+		t.Fatalf("got %s definition with representation %s", definition.Type, definition.Representation)
+	}
+	next, err := GetDataTypeMapEntry(definition.Children, "Next")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.Type != TypeRef || next.Ref != typeId || !next.Nullable {
+		t.Errorf("got Next of type %s with ref %s, nullable %t", next.Type, next.Ref, next.Nullable)
+	}
+	children, err := GetDataTypeMapEntry(definition.Children, "Children")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if children.Type != TypeArray || children.Contains.Type != TypeRef || children.Contains.Ref != typeId {
+		t.Errorf("got Children of type %s containing %s", children.Type, children.Contains.Type)
+	}
+
+	// Reflecting the type again reuses the definition
+	GetDataType(reflect.TypeOf(&testNode{}), definitions)
+	if len(definitions) != 1 || !reflect.DeepEqual(definitions[typeId], definition) {
+		t.Error("definition changed when reflected again")
+	}
+}
@@ -0,0 +1,42 @@
+package descriptors
+
+// Meaning of a data type beyond its JSON type, for rendering and validation
+const (
+	SemanticAddress                    = "Address"
+	SemanticBigInt                     = "BigInt"
+	SemanticTokenAmount                = "TokenAmount"
+	SemanticStoragePower               = "StoragePower"
+	SemanticDealWeight                 = "DealWeight"
+	SemanticSectorQuality              = "SectorQuality"
+	SemanticCid                        = "Cid"
+	SemanticBitField                   = "BitField"
+	SemanticChainEpoch                 = "ChainEpoch"
+	SemanticActorID                    = "ActorID"
+	SemanticMethodNum                  = "MethodNum"
+	SemanticSectorNumber               = "SectorNumber"
+	SemanticSectorSize                 = "SectorSize"
+	SemanticDealID                     = "DealID"
+	SemanticPaddedPieceSize            = "PaddedPieceSize"
+	SemanticUnpaddedPieceSize          = "UnpaddedPieceSize"
+	SemanticPeerID                     = "PeerID"
+	SemanticMultiaddr                  = "Multiaddr"
+	SemanticRandomness                 = "Randomness"
+	SemanticSignature                  = "Signature"
+	SemanticSigType                    = "SigType"
+	SemanticExitCode                   = "ExitCode"
+	SemanticRegisteredSealProof        = "RegisteredSealProof"
+	SemanticRegisteredPoStProof        = "RegisteredPoStProof"
+	SemanticRegisteredAggregationProof = "RegisteredAggregationProof"
+	SemanticRegisteredUpdateProof      = "RegisteredUpdateProof"
+	SemanticDealLabel                  = "DealLabel"
+	SemanticBytecodeReturn             = "BytecodeReturn"
+)
+
+// Sets the semantic of a field, or of its elements for slices of aliases
+// such as []abi.Multiaddrs
+func setFieldSemantic(dataType *DataType, semantic string) {
+	for dataType.Type == TypeArray && dataType.Contains != nil {
+		dataType = dataType.Contains
+	}
+	dataType.Semantic = semantic
+}
@@ -0,0 +1,103 @@
+package descriptors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/filecoin-project/go-state-types/abi"
+)
+
+// Maximum size of a request body, above that of any message params
+const maxRequestBytes = 8 << 20
+
+// Returns an HTTP handler that serves the files in the descriptors
+// directory, and decodes and encodes params and return values:
+//
+//	POST /decode?actor=<name or code>&method=<number>[&return=true]  CBOR -> JSON
+//	POST /encode?actor=<name or code>&method=<number>[&return=true]  JSON -> CBOR
+func NewDescriptorsHandler(descriptors *Descriptors, dir string) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/", http.FileServer(http.Dir(dir)))
+
+	mux.HandleFunc("/decode", func(w http.ResponseWriter, r *http.Request) {
+		actor, methodNum, isReturn, data, err := readMethodRequest(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), getRequestErrorStatus(err))
+			return
+		}
+
+		var value interface{}
+		if isReturn {
+			value, err = descriptors.DecodeReturn(actor, methodNum, data)
+		} else {
+			value, err = descriptors.DecodeParams(actor, methodNum, data)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(value)
+	})
+
+	mux.HandleFunc("/encode", func(w http.ResponseWriter, r *http.Request) {
+		actor, methodNum, isReturn, data, err := readMethodRequest(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), getRequestErrorStatus(err))
+			return
+		}
+
+		var encoded []byte
+		if isReturn {
+			encoded, err = descriptors.EncodeReturn(actor, methodNum, data)
+		} else {
+			encoded, err = descriptors.EncodeParams(actor, methodNum, data)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/cbor")
+		w.Write(encoded)
+	})
+
+	return mux
+}
+
+func readMethodRequest(w http.ResponseWriter, r *http.Request) (string, abi.MethodNum, bool, []byte, error) {
+	if r.Method != http.MethodPost {
+		return "", 0, false, nil, fmt.Errorf("expected POST request")
+	}
+
+	query := r.URL.Query()
+	actor := query.Get("actor")
+	if actor == "" {
+		return "", 0, false, nil, fmt.Errorf("missing actor")
+	}
+	methodNum, err := strconv.ParseUint(query.Get("method"), 10, 64)
+	if err != nil {
+		return "", 0, false, nil, fmt.Errorf("invalid method number %s", query.Get("method"))
+	}
+	isReturn := query.Get("return") == "true"
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	if err != nil {
+		return "", 0, false, nil, err
+	}
+
+	return actor, abi.MethodNum(methodNum), isReturn, data, nil
+}
+
+func getRequestErrorStatus(err error) int {
+	var maxBytesError *http.MaxBytesError
+	if errors.As(err, &maxBytesError) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
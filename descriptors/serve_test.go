@@ -0,0 +1,19 @@
+package descriptors
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServeRejectsLargeBody(t *testing.T) {
+	handler := NewDescriptorsHandler(&Descriptors{}, t.TempDir())
+	body := bytes.NewReader(make([]byte, maxRequestBytes+1))
+	request := httptest.NewRequest(http.MethodPost, "/decode?actor=storageminer&method=3", body)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("got status %d, expected %d", recorder.Code, http.StatusRequestEntityTooLarge)
+	}
+}
@@ -0,0 +1,53 @@
+package descriptors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+)
+
+// Actor state decoded with the descriptor of the actor
+type DecodedActorState struct {
+	Address string // Address as given
+	ID      string // ID address
+	Code    cid.Cid
+	Head    cid.Cid // CID of the actor state
+	Key     ActorDescriptorKey
+	State   interface{}
+}
+
+// Reads the state of the actor behind an address at the pinned tipset or
+// head, and decodes it with the State of the actor descriptor. Unlike
+// StateReadState, this does not depend on Lotus knowing the actors version.
+func (l *Lotus) ReadActorState(ctx context.Context, d *Descriptors, addr string) (DecodedActorState, error) {
+	actor, err := l.ResolveActor(ctx, d, addr)
+	if err != nil {
+		return DecodedActorState{}, err
+	}
+
+	// Read actor head from Lotus
+	var object []byte
+	err = l.call(ctx, "ChainReadObj", func(ctx context.Context) (err error) {
+		object, err = l.api.ChainReadObj(ctx, actor.Head)
+		return err
+	})
+	if err != nil {
+		return DecodedActorState{}, err
+	}
+
+	// Decode actor head with descriptor
+	state, err := d.decodeActorState(actor.Key.Name, actor.Descriptor, object)
+	if err != nil {
+		return DecodedActorState{}, fmt.Errorf("failed to decode state of %s: %w", addr, err)
+	}
+
+	return DecodedActorState{
+		Address: actor.Address,
+		ID:      actor.ID,
+		Code:    actor.Code,
+		Head:    actor.Head,
+		Key:     actor.Key,
+		State:   state,
+	}, nil
+}
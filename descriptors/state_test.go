@@ -0,0 +1,50 @@
+package descriptors
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/builtin/v10/account"
+	"github.com/filecoin-project/lotus/chain/types"
+)
+
+func TestReadActorState(t *testing.T) {
+	fake, meta := newTestFakeLotusAPI(t)
+	d, err := BuildDescriptors()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secp, err := address.NewSecp256k1Address([]byte("public key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	object := marshalTestValue(t, &account.State{Address: secp})
+	head, err := abi.CidBuilder.Sum(object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.Objects[head] = object
+	fake.Actors = map[address.Address]*types.Actor{
+		mustIDAddress(t, 1000): {Code: meta.Actors["account"], Head: head},
+	}
+
+	state, err := NewLotus(fake, LotusOptions{}).ReadActorState(context.Background(), d, "f01000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Code != meta.Actors["account"] || state.Head != head {
+		t.Errorf("got code %s and head %s", state.Code, state.Head)
+	}
+
+	stateJson, err := json.Marshal(state.State)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `{"Address":"` + secp.String() + `"}`; string(stateJson) != expected {
+		t.Errorf("got state %s, expected %s", stateJson, expected)
+	}
+}
@@ -0,0 +1,167 @@
+package descriptors
+
+import (
+	"github.com/filecoin-project/go-state-types/abi"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/network"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"github.com/iancoleman/orderedmap"
+)
+
+type ActorName = string
+type ActorCode = string
+type PropName = string
+type TypeId = string
+type ActorsVersion = actorstypes.Version
+
+type ActorCodeMap = map[ActorName]ActorCode
+
+// Actor codes of a network, with the bundle and chain they were read from.
+// Only the manifest CID and actor codes are known for bundles.
+type NetworkManifest struct {
+	ManifestCid    string
+	Version        ActorsVersion   `json:",omitempty"` // Zero if unknown
+	NetworkVersion network.Version `json:",omitempty"`
+	Height         abi.ChainEpoch  `json:",omitempty"` // Height of the tipset the codes were read at
+	EthChainId     *uint64         `json:",omitempty"` // Nil if it could not be read, such as with the Eth RPC disabled
+	GenesisCid     string          `json:",omitempty"`
+	ActorCodeMap   ActorCodeMap
+}
+
+type NetworkActorCodeMap = map[dtypes.NetworkName]NetworkManifest
+
+// Actor codes of a network over the epochs between two upgrades
+type HistoricalActorCodes struct {
+	Version      ActorsVersion
+	FromEpoch    abi.ChainEpoch  // Upgrade epoch, or zero from genesis
+	ToEpoch      *abi.ChainEpoch `json:",omitempty"` // Next upgrade epoch, nil while active
+	ManifestCid  string
+	ActorCodeMap ActorCodeMap
+}
+
+type NetworkActorCodeHistory = map[dtypes.NetworkName][]HistoricalActorCodes
+
+const (
+	TypeBool      = "boolean"
+	TypeNumber    = "number"
+	TypeString    = "string"
+	TypeBytes     = "bytes"
+	TypeMap       = "map"
+	TypeArray     = "array"
+	TypeChan      = "channel"
+	TypeObject    = "object"
+	TypeFunction  = "function"
+	TypeInterface = "interface"
+	TypeRef       = "reference"
+)
+
+// CBOR representations, derived from the cbor-gen marshalling
+const (
+	ReprTuple  = "tuple"  // Struct encoded as array, see CborIndex
+	ReprMap    = "map"    // Struct encoded as map, see CborKey
+	ReprLink   = "link"   // CID encoded as tag 42
+	ReprEmpty  = "empty"  // Encoded as zero bytes
+	ReprRaw    = "raw"    // Bytes written without CBOR header
+	ReprCustom = "custom" // Hand-written marshalling, identified by Name
+)
+
+type DataType struct {
+	Type       string
+	Name       string
+	Key        *DataType   `json:",omitempty"` // For map type
+	Contains   *DataType   `json:",omitempty"` // For map / array / channel type
+	Children   DataTypeMap `json:",omitempty"` // For object type
+	Methods    DataTypeMap `json:",omitempty"` // For interface type
+	Params     []DataType  `json:",omitempty"` // For function type
+	Returns    []DataType  `json:",omitempty"` // For function type
+	IsVariadic bool        `json:",omitempty"` // For function type
+	ChanDir    string      `json:",omitempty"` // For channel type
+	Ref        TypeId      `json:",omitempty"` // For reference type
+	Nullable   bool        `json:",omitempty"` // For pointer types
+	Semantic   string      `json:",omitempty"` // Meaning of known types, see TypeOverride
+	Container  *Container  `json:",omitempty"` // For links to collection roots
+	Enum       []EnumValue `json:",omitempty"` // For number types with named values
+	EnumOpen   bool        `json:",omitempty"` // For enums that allow values beyond the named ones
+
+	Representation string `json:",omitempty"` // For object / bytes type
+	CborIndex      *int   `json:",omitempty"` // For tuple object children, skipped fields have none
+	CborKey        string `json:",omitempty"` // For map object children, skipped fields have none
+}
+
+// Collection kinds of linked state
+const (
+	ContainerHamt = "hamt"
+	ContainerAmt  = "amt"
+	ContainerKamt = "kamt"
+)
+
+// Encodings of HAMT and KAMT keys, AMT keys are indexes
+const (
+	KeyAddress = "address" // Address bytes
+	KeyUvarint = "uvarint" // Unsigned varint, also ID address payloads
+	KeyVarint  = "varint"  // Signed varint
+	KeyCid     = "cid"     // CID bytes
+	KeyCbor    = "cbor"    // CBOR encoded Key type
+	KeyBytes   = "bytes"   // Raw bytes
+)
+
+// Collection whose root a link points to, with its key and value types
+type Container struct {
+	Kind        string
+	BitWidth    int
+	KeyEncoding string    `json:",omitempty"` // For HAMT / KAMT
+	Key         *DataType `json:",omitempty"` // For HAMT / KAMT
+	Value       *DataType `json:",omitempty"` // Nil for sets
+}
+
+// Named value of an enum-like integer type, such as StackedDrg32GiBV1_1
+// of abi.RegisteredSealProof
+type EnumValue struct {
+	Name  string
+	Value int64
+}
+
+type enumType struct {
+	Open   bool
+	Values []EnumValue
+}
+
+type DataTypeMap = *orderedmap.OrderedMap
+
+// Named struct and interface types, referenced by TypeRef data types
+type DataTypeDefinitions = map[TypeId]DataType
+
+type ActorMethod struct {
+	Name   string
+	Param  DataType
+	Return DataType
+}
+
+type ActorMethodMap = map[abi.MethodNum]ActorMethod
+
+type ActorDescriptor struct {
+	State   DataTypeMap
+	Methods ActorMethodMap
+}
+
+type ActorDescriptorMap = map[ActorName]ActorDescriptor
+
+type VersionedActorDescriptorMap = map[ActorsVersion]ActorDescriptorMap
+
+// Key into VersionedActorDescriptorMap
+type ActorDescriptorKey struct {
+	Version ActorsVersion
+	Name    ActorName
+}
+
+type ActorDescriptorIndex = map[ActorCode]ActorDescriptorKey
+
+// Network, actor and actors version of an actor code
+type ActorCodeEntry struct {
+	Network       dtypes.NetworkName
+	Name          ActorName
+	Version       ActorsVersion
+	DescriptorKey *ActorDescriptorKey `json:",omitempty"` // Nil if there are no descriptors for the version
+}
+
+type ActorCodeIndex = map[ActorCode]ActorCodeEntry
@@ -0,0 +1,316 @@
+package descriptors
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/filecoin-project/go-state-types/abi"
+)
+
+// Branded types prevent mixing up values that share a representation
+const typeScriptHeader = `// Generated by filecoin-descriptors, do not edit
+
+export type FilecoinNumber = string & { readonly __brand: 'FilecoinNumber' }
+export type CidString = string & { readonly __brand: 'CidString' }
+export type Address = string & { readonly __brand: 'Address' }
+export type Cid = { '/': CidString }
+
+/** Base64 encoded bytes */
+export type Bytes = string
+`
+
+var typeScriptIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+var typeScriptInvalidChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// Returns TypeScript declarations for the actor descriptors of one actors
+// version: a namespace with an interface per referenced Go package struct,
+// followed by the actor states and methods keyed by actor and method number.
+func GetTypeScriptDeclarations(actorDescriptorMap ActorDescriptorMap, definitions DataTypeDefinitions) (string, error) {
+	builder := typeScriptBuilder{
+		definitions: definitions,
+		namespaces:  map[string]string{},
+		used:        map[TypeId]bool{},
+	}
+
+	// Sort actor names for deterministic output
+	names := make([]ActorName, 0, len(actorDescriptorMap))
+	for name := range actorDescriptorMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Actor states
+	var states strings.Builder
+	states.WriteString("export interface States {\n")
+	for _, name := range names {
+		descriptor := actorDescriptorMap[name]
+		if descriptor.State == nil {
+			continue
+		}
+		stateDataType := DataType{Type: TypeObject, Representation: ReprTuple, Children: descriptor.State}
+		stateType, err := builder.getType(stateDataType, 1)
+		if err != nil {
+			return "", fmt.Errorf("%s state: %w", name, err)
+		}
+		fmt.Fprintf(&states, "  %s: %s\n", quoteTypeScriptKey(name), stateType)
+	}
+	states.WriteString("}\n")
+
+	// Actor methods
+	var methods strings.Builder
+	methods.WriteString("export interface Methods {\n")
+	for _, name := range names {
+		descriptor := actorDescriptorMap[name]
+		fmt.Fprintf(&methods, "  %s: {\n", quoteTypeScriptKey(name))
+		for _, methodNum := range getSortedMethodNums(descriptor.Methods) {
+			method := descriptor.Methods[methodNum]
+			paramType, err := builder.getType(method.Param, 3)
+			if err != nil {
+				return "", fmt.Errorf("%s method %s: %w", name, method.Name, err)
+			}
+			returnType, err := builder.getType(method.Return, 3)
+			if err != nil {
+				return "", fmt.Errorf("%s method %s: %w", name, method.Name, err)
+			}
+			fmt.Fprintf(&methods, "    %d: {\n", methodNum)
+			fmt.Fprintf(&methods, "      Name: '%s'\n", method.Name)
+			fmt.Fprintf(&methods, "      Param: %s\n", paramType)
+			fmt.Fprintf(&methods, "      Return: %s\n", returnType)
+			methods.WriteString("    }\n")
+		}
+		methods.WriteString("  }\n")
+	}
+	methods.WriteString("}\n")
+
+	// Named types, which may reference further named types
+	declarations, err := builder.getDeclarations()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{typeScriptHeader, declarations, states.String(), methods.String()}, "\n"), nil
+}
+
+type typeScriptBuilder struct {
+	definitions DataTypeDefinitions
+	namespaces  map[string]string // Package path by namespace
+	used        map[TypeId]bool
+}
+
+// Returns the declarations of all used definitions, grouped in a
+// namespace per Go package
+func (b *typeScriptBuilder) getDeclarations() (string, error) {
+	byNamespace := map[string][]string{}
+	done := map[TypeId]bool{}
+	for {
+
+		// Sort pending type IDs for deterministic namespaces
+		var pending []TypeId
+		for typeId := range b.used {
+			if !done[typeId] {
+				pending = append(pending, typeId)
+			}
+		}
+		if len(pending) == 0 {
+			break
+		}
+		sort.Strings(pending)
+
+		for _, typeId := range pending {
+			done[typeId] = true
+
+			definition, ok := b.definitions[typeId]
+			if !ok {
+				return "", fmt.Errorf("missing definition for %s", typeId)
+			}
+			declaration, err := b.getDeclaration(definition)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", typeId, err)
+			}
+			namespace := b.getNamespace(typeId)
+			byNamespace[namespace] = append(byNamespace[namespace], declaration)
+		}
+	}
+
+	namespaces := make([]string, 0, len(byNamespace))
+	for namespace := range byNamespace {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	var sb strings.Builder
+	for _, namespace := range namespaces {
+		declarations := byNamespace[namespace]
+		sort.Strings(declarations)
+		fmt.Fprintf(&sb, "/** %s */\n", b.namespaces[namespace])
+		fmt.Fprintf(&sb, "export namespace %s {\n", namespace)
+		sb.WriteString(strings.Join(declarations, "\n"))
+		sb.WriteString("}\n\n")
+	}
+	return sb.String(), nil
+}
+
+func (b *typeScriptBuilder) getDeclaration(definition DataType) (string, error) {
+	typeString, err := b.getType(definition, 1)
+	if err != nil {
+		return "", err
+	}
+
+	// Objects become interfaces, other types become aliases
+	if strings.HasPrefix(typeString, "{") {
+		return fmt.Sprintf("  export interface %s %s\n", definition.Name, typeString), nil
+	}
+	return fmt.Sprintf("  export type %s = %s\n", definition.Name, typeString), nil
+}
+
+// Returns the namespace for the package of a type ID, which is the
+// package name, suffixed with a number when it is already taken
+func (b *typeScriptBuilder) getNamespace(typeId TypeId) string {
+	pkgPath := typeId[:strings.LastIndex(typeId, ".")]
+	name := typeScriptInvalidChars.ReplaceAllString(path.Base(pkgPath), "_")
+	for i := 1; ; i++ {
+		namespace := name
+		if i > 1 {
+			namespace = fmt.Sprintf("%s%d", name, i)
+		}
+		if existing, ok := b.namespaces[namespace]; !ok || existing == pkgPath {
+			b.namespaces[namespace] = pkgPath
+			return namespace
+		}
+	}
+}
+
+func (b *typeScriptBuilder) getType(dataType DataType, indent int) (string, error) {
+	typeString, err := b.getNonNullType(dataType, indent)
+	if err != nil {
+		return "", err
+	}
+
+	// Nil pointers are represented as null
+	if dataType.Nullable && typeString != "null" {
+		return typeString + " | null", nil
+	}
+	return typeString, nil
+}
+
+func (b *typeScriptBuilder) getNonNullType(dataType DataType, indent int) (string, error) {
+
+	// Referenced definitions are declared separately
+	if dataType.Type == TypeRef {
+		if _, ok := b.definitions[dataType.Ref]; !ok {
+			return "", fmt.Errorf("missing definition for %s", dataType.Ref)
+		}
+		b.used[dataType.Ref] = true
+		return b.getNamespace(dataType.Ref) + "." + dataType.Name, nil
+	}
+
+	// Handle types with custom encoding
+	switch dataType.Semantic {
+	case SemanticAddress:
+		return "Address", nil
+	case SemanticBigInt, SemanticTokenAmount, SemanticStoragePower, SemanticDealWeight, SemanticSectorQuality:
+		return "FilecoinNumber", nil
+	case SemanticBitField:
+		return "number[]", nil
+	case SemanticDealLabel:
+		return "string | { Bytes: Bytes }", nil
+	}
+
+	// Handle base types
+	switch dataType.Type {
+
+	case TypeBool:
+		return "boolean", nil
+
+	case TypeNumber:
+		if len(dataType.Enum) == 0 || dataType.EnumOpen {
+			return "number", nil
+		}
+		var values []string
+		for _, value := range dataType.Enum {
+			values = append(values, fmt.Sprint(value.Value))
+		}
+		return strings.Join(values, " | "), nil
+
+	case TypeString:
+		return "string", nil
+
+	case TypeBytes:
+		return "Bytes", nil
+
+	case TypeArray:
+		itemType, err := b.getType(*dataType.Contains, indent)
+		if err != nil {
+			return "", err
+		}
+		if strings.Contains(itemType, "|") {
+			itemType = "(" + itemType + ")"
+		}
+		return itemType + "[]", nil
+
+	case TypeMap:
+		valueType, err := b.getType(*dataType.Contains, indent)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Record<string, %s>", valueType), nil
+
+	case TypeObject:
+		switch dataType.Representation {
+		case ReprEmpty:
+			return "null", nil
+		case ReprLink:
+			return "Cid", nil
+		}
+
+		// Objects contain their encoded fields
+		fields, err := getCborFields(dataType)
+		if err != nil {
+			return "", err
+		}
+		if dataType.Representation == ReprCustom {
+			if fields, err = getAllFields(dataType); err != nil {
+				return "", err
+			}
+		}
+		if len(fields) == 0 {
+			return "{}", nil
+		}
+		padding := strings.Repeat("  ", indent)
+		var sb strings.Builder
+		sb.WriteString("{\n")
+		for _, field := range fields {
+			fieldType, err := b.getType(field.DataType, indent+1)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&sb, "%s  %s: %s\n", padding, quoteTypeScriptKey(field.Name), fieldType)
+		}
+		sb.WriteString(padding + "}")
+		return sb.String(), nil
+
+	case TypeInterface:
+		return "unknown", nil
+	}
+
+	return "", fmt.Errorf("cannot create TypeScript type for %s of type %s", dataType.Name, dataType.Type)
+}
+
+func quoteTypeScriptKey(key string) string {
+	if typeScriptIdentifier.MatchString(key) {
+		return key
+	}
+	return fmt.Sprintf("'%s'", key)
+}
+
+func getSortedMethodNums(methods ActorMethodMap) []abi.MethodNum {
+	methodNums := make([]abi.MethodNum, 0, len(methods))
+	for methodNum := range methods {
+		methodNums = append(methodNums, methodNum)
+	}
+	sort.Slice(methodNums, func(i, j int) bool { return methodNums[i] < methodNums[j] })
+	return methodNums
+}
@@ -0,0 +1,111 @@
+package descriptors
+
+import (
+	"testing"
+
+	"github.com/iancoleman/orderedmap"
+)
+
+// Returns the children of an object, with tuple indexes if tuple is set
+func newTestChildren(tuple bool, fields ...interface{}) DataTypeMap {
+	children := orderedmap.New()
+	for i := 0; i < len(fields); i += 2 {
+		dataType := fields[i+1].(DataType)
+		if tuple {
+			cborIndex := i / 2
+			dataType.CborIndex = &cborIndex
+		}
+		children.Set(fields[i].(string), dataType)
+	}
+	return children
+}
+
+func TestGetTypeScriptDeclarations(t *testing.T) {
+	proof := DataType{Type: TypeNumber, Name: "RegisteredSealProof", Enum: []EnumValue{{"StackedDrg32GiBV1_1", 8}, {"StackedDrg64GiBV1_1", 9}}}
+	exitCode := DataType{Type: TypeNumber, Name: "ExitCode", Enum: []EnumValue{{"Ok", 0}}, EnumOpen: true}
+	infoRef := DataType{Type: TypeRef, Name: "Info", Ref: "example.com/a/miner.Info"}
+	deadlineRef := DataType{Type: TypeRef, Name: "Deadline", Ref: "example.com/b/miner.Deadline"}
+
+	// Packages named alike share the name in different namespaces
+	definitions := DataTypeDefinitions{
+		"example.com/a/miner.Info": {Type: TypeObject, Name: "Info", Representation: ReprTuple, Children: newTestChildren(true,
+			"Owner", DataType{Type: TypeString, Name: "Address", Semantic: SemanticAddress},
+			"Proofs", DataType{Type: TypeArray, Contains: &proof},
+			"Proof", proof,
+			"Exit", exitCode,
+			"Pending", DataType{Type: TypeArray, Contains: &DataType{Type: TypeString, Nullable: true}},
+		)},
+		"example.com/b/miner.Deadline": {Type: TypeObject, Name: "Deadline", Representation: ReprCustom, Children: newTestChildren(false,
+			"Epoch", DataType{Type: TypeNumber},
+			"Sectors", DataType{Type: TypeArray, Name: "BitField", Semantic: SemanticBitField},
+		)},
+	}
+	nullableInfoRef := infoRef
+	nullableInfoRef.Nullable = true
+
+	actorDescriptorMap := ActorDescriptorMap{
+		"storageminer": {
+			State: newTestChildren(true,
+				"Info", nullableInfoRef,
+				"Deadlines", DataType{Type: TypeArray, Contains: &deadlineRef},
+				"Balance", DataType{Type: TypeString, Name: "TokenAmount", Semantic: SemanticTokenAmount},
+			),
+			Methods: ActorMethodMap{
+				2: {Name: "ChangeInfo", Param: infoRef, Return: DataType{Type: TypeObject, Representation: ReprEmpty}},
+				1: {Name: "Constructor", Param: DataType{Type: TypeObject, Representation: ReprTuple, Children: orderedmap.New()}, Return: DataType{Type: TypeObject, Representation: ReprLink, Nullable: true}},
+			},
+		},
+	}
+
+	declarations, err := GetTypeScriptDeclarations(actorDescriptorMap, definitions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := typeScriptHeader + `
+/** example.com/a/miner */
+export namespace miner {
+  export interface Info {
+    Owner: Address
+    Proofs: (8 | 9)[]
+    Proof: 8 | 9
+    Exit: number
+    Pending: (string | null)[]
+  }
+}
+
+/** example.com/b/miner */
+export namespace miner2 {
+  export interface Deadline {
+    Epoch: number
+    Sectors: number[]
+  }
+}
+
+
+export interface States {
+  storageminer: {
+    Info: miner.Info | null
+    Deadlines: miner2.Deadline[]
+    Balance: FilecoinNumber
+  }
+}
+
+export interface Methods {
+  storageminer: {
+    1: {
+      Name: 'Constructor'
+      Param: {}
+      Return: Cid | null
+    }
+    2: {
+      Name: 'ChangeInfo'
+      Param: miner.Info
+      Return: null
+    }
+  }
+}
+`
+	if declarations != expected {
+		t.Errorf("got declarations:\n%s\nexpected:\n%s", declarations, expected)
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package descriptors
 
 import (
 	"bytes"
@@ -7,7 +7,7 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/ipfs/go-hamt-ipld"
+	hamt "github.com/filecoin-project/go-hamt-ipld/v3"
 	"github.com/ipld/go-ipld-prime/codec/dagcbor"
 	"github.com/ipld/go-ipld-prime/datamodel"
 	"github.com/ipld/go-ipld-prime/node/basicnode"
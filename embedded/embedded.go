@@ -0,0 +1,91 @@
+//go:generate go run .. descriptors -format json -output .
+//go:generate go run .. codes -offline -output .
+
+// Package embedded provides the generated actor descriptors and actor
+// codes, embedded in the binary, without running the generator or
+// reaching a Lotus node. Actor names resolve to the latest actors version
+// that contains the actor.
+package embedded
+
+import (
+	"embed"
+	"fmt"
+	"sync"
+
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/glifio/filecoin-descriptors/descriptors"
+	"github.com/ipfs/go-cid"
+)
+
+//go:embed actor-descriptors.json type-definitions.json actor-codes.json actor-descriptor-index.json code-index.json
+var files embed.FS
+
+var loaded struct {
+	once        sync.Once
+	descriptors *descriptors.Descriptors
+	err         error
+}
+
+// Returns the embedded descriptors, which are parsed on first use
+func Descriptors() (*descriptors.Descriptors, error) {
+	loaded.once.Do(func() {
+		loaded.descriptors, loaded.err = descriptors.LoadDescriptorsFS(files)
+	})
+	return loaded.descriptors, loaded.err
+}
+
+// Returns the descriptor key and descriptor of an actor code
+func LookupByCode(code cid.Cid) (descriptors.ActorDescriptorKey, descriptors.ActorDescriptor, error) {
+	d, err := Descriptors()
+	if err != nil {
+		return descriptors.ActorDescriptorKey{}, descriptors.ActorDescriptor{}, err
+	}
+
+	key, ok := d.Index[code.String()]
+	if !ok {
+		return key, descriptors.ActorDescriptor{}, fmt.Errorf("unknown actor code: %s", code)
+	}
+	descriptor, ok := d.Actors[key.Version][key.Name]
+	if !ok {
+		return key, descriptor, fmt.Errorf("no descriptor for %s actor version %d", key.Name, key.Version)
+	}
+
+	return key, descriptor, nil
+}
+
+// Returns a method of an actor name or code
+func Method(actor string, methodNum abi.MethodNum) (descriptors.ActorMethod, error) {
+	d, err := Descriptors()
+	if err != nil {
+		return descriptors.ActorMethod{}, err
+	}
+	return d.GetActorMethod(actor, methodNum)
+}
+
+// Returns the state of an actor name or code as object DataType
+func StateType(actor string) (descriptors.DataType, error) {
+	d, err := Descriptors()
+	if err != nil {
+		return descriptors.DataType{}, err
+	}
+	return d.GetStateType(actor)
+}
+
+// Returns the actor codes of each network
+func ActorCodes() (descriptors.NetworkActorCodeMap, error) {
+	d, err := Descriptors()
+	if err != nil {
+		return nil, err
+	}
+	return d.Codes, nil
+}
+
+// Returns the network, actor and actors version of an actor code of any
+// actors version embedded in Lotus
+func ActorCodeEntry(code cid.Cid) (descriptors.ActorCodeEntry, error) {
+	d, err := Descriptors()
+	if err != nil {
+		return descriptors.ActorCodeEntry{}, err
+	}
+	return d.GetActorCodeEntry(code)
+}
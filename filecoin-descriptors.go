@@ -3,165 +3,493 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"flag"
 	"fmt"
-	"log"
 	"os"
-	"runtime"
+	"path/filepath"
 	"strings"
+	"time"
 
-	"reflect"
-
-	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/node/modules/dtypes"
+	"github.com/glifio/filecoin-descriptors/descriptors"
 )
 
-var apiUrls = []string{
+var defaultEndpoints = []string{
 	"https://api.node.glif.io/rpc/v1",
 	"https://api.calibration.node.glif.io/rpc/v1",
 }
 
+type command struct {
+	name        string
+	usage       string
+	description string
+	run         func(args []string) error
+}
+
+var commands []command
+
+// Commands are set in init, as their flag usage refers back to them
+func init() {
+	commands = []command{
+		{"codes", "codes [flags]", "Write actor codes of each network and the descriptor index", runCodes},
+		{"descriptors", "descriptors [flags]", "Write actor descriptors, type definitions, JSON schemas and TypeScript declarations", runDescriptors},
+		{"generate", "generate -config <file>", "Write the outputs of the configuration file, running descriptors and codes", runGenerate},
+		{"decode", "decode [flags] <actor> <method> [data]", "Decode CBOR params or return value to JSON", runDecode},
+		{"encode", "encode [flags] <actor> <method> [json]", "Encode JSON params or return value to CBOR", runEncode},
+		{"actor", "actor [flags] <address>", "Resolve an address to its actor code and descriptor through Lotus", runActor},
+		{"state", "state [flags] <address>", "Read and decode the state of an actor through Lotus", runState},
+		{"diff", "diff [flags] <from version> <to version>", "Compare actor descriptors of two actors versions", runDiff},
+		{"serve", "serve [flags]", "Serve descriptors and decode / encode over HTTP", runServe},
+	}
+}
+
 func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != os.Args[1] {
+			continue
+		}
+		if err := cmd.run(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", cmd.name, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if os.Args[1] != "help" && os.Args[1] != "-h" && os.Args[1] != "--help" {
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n", os.Args[1])
+		printUsage()
+		os.Exit(2)
+	}
+	printUsage()
+}
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: filecoin-descriptors <command> [flags]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-12s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun filecoin-descriptors <command> -h for the flags of a command.\n")
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	flags.Usage = func() {
+		for _, cmd := range commands {
+			if cmd.name == name {
+				fmt.Fprintf(flags.Output(), "Usage: filecoin-descriptors %s\n\n%s\n\nFlags:\n", cmd.usage, cmd.description)
+			}
+		}
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// Flag that may be repeated, or given as a comma separated list
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	for _, elem := range strings.Split(value, ",") {
+		if elem = strings.TrimSpace(elem); elem != "" {
+			*l = append(*l, elem)
+		}
+	}
+	return nil
+}
+
+func (l stringList) Contains(value string) bool {
+	for _, elem := range l {
+		if elem == value {
+			return true
+		}
+	}
+	return false
+}
+
+func runCodes(args []string) error {
+	var endpoints, networks, bundleFiles stringList
+	flags := newFlagSet("codes")
+	flags.Var(&endpoints, "endpoint", "Lotus RPC endpoint, may be repeated (default "+strings.Join(defaultEndpoints, ",")+")")
+	token := flags.String("token", "", "Lotus RPC auth token, for endpoints without a token as user info, such as https://<token>@host/rpc/v1")
+	timeout := flags.Duration("timeout", descriptors.DefaultLotusOptions.Timeout, "Deadline of each Lotus RPC call")
+	retries := flags.Int("retries", descriptors.DefaultLotusOptions.Retries, "Retries of Lotus RPC calls that fail with a transient error")
+	flags.Var(&networks, "network", "Only include the network, may be repeated (default all)")
+	offline := flags.Bool("offline", false, "Read actor codes from the builtin-actors bundles embedded in Lotus")
+	flags.Var(&bundleFiles, "bundle", "Read actor codes of a network from a builtin-actors bundle CAR file, as <network>=<path>, may be repeated")
+	history := flags.Bool("history", false, "Also retrieve the actor codes of past upgrades from Lotus")
+	outputDir := flags.String("output", "output", "Output directory")
+	configPath := flags.String("config", "", "Configuration file with networks, replacing the endpoint, token, offline, bundle and history flags")
+	flags.Parse(args)
+
+	// Bundles replace the endpoints, so both can't be given
+	if len(endpoints) > 0 && (*offline || len(bundleFiles) > 0) {
+		flags.Usage()
+		return fmt.Errorf("the endpoint flag can't be combined with the offline or bundle flags")
+	}
+	if len(endpoints) == 0 && !*offline && len(bundleFiles) == 0 {
+		endpoints = defaultEndpoints
+	}
+	config, err := loadConfig(*configPath, flags, outputDir, timeout, retries)
+	if err != nil {
+		return err
+	}
+
 	/*
-	 * Preparation
+	 * Actor descriptors
 	 */
 
-	// Create output directory if not exists
-	if err := os.MkdirAll("output", os.ModePerm); err != nil {
-		log.Fatalf("Failed to create output directory: %v", err)
+	versionedActorDescriptorMap, err := descriptors.GetVersionedActorDescriptorMap(descriptors.DataTypeDefinitions{})
+	if err != nil {
+		return fmt.Errorf("failed to get actor descriptors: %w", err)
+	}
+
+	/*
+	 * Networks
+	 */
+
+	options := descriptors.DefaultLotusOptions
+	options.Token = *token
+	options.Timeout = *timeout
+	options.Retries = *retries
+
+	var bundles []descriptors.Bundle
+	var lotusEndpoints []descriptors.LotusEndpoint
+	if config != nil {
+
+		// Configured networks
+		for _, network := range config.Networks {
+			if network.Bundle != "" {
+				bundle, err := descriptors.ReadBundleFile(network.Name, network.Bundle)
+				if err != nil {
+					return fmt.Errorf("failed to read actor codes: %w", err)
+				}
+				bundles = append(bundles, bundle)
+				continue
+			}
+			lotusEndpoint, err := network.GetLotusEndpoint(options)
+			if err != nil {
+				return err
+			}
+			lotusEndpoints = append(lotusEndpoints, lotusEndpoint)
+		}
+	} else {
+
+		// Read actor codes from builtin-actors bundles when offline
+		if *offline {
+			bundles = descriptors.GetEmbeddedBundles()
+		}
+		for _, bundleFile := range bundleFiles {
+			network, path, ok := strings.Cut(bundleFile, "=")
+			if !ok {
+				return fmt.Errorf("invalid bundle %s, expected <network>=<path>", bundleFile)
+			}
+			bundle, err := descriptors.ReadBundleFile(dtypes.NetworkName(network), path)
+			if err != nil {
+				return fmt.Errorf("failed to read actor codes: %w", err)
+			}
+			bundles = append(bundles, bundle)
+		}
+
+		// Lotus RPC endpoints without bundles
+		for _, endpoint := range endpoints {
+			// Endpoints may carry their own token
+			url, endpointToken, err := descriptors.ParseEndpoint(endpoint)
+			if err != nil {
+				return err
+			}
+			endpointOptions := options
+			if endpointToken != "" {
+				endpointOptions.Token = endpointToken
+			}
+			lotusEndpoints = append(lotusEndpoints, descriptors.LotusEndpoint{URL: url, Options: endpointOptions, History: *history})
+		}
 	}
 
 	/*
 	 * Actor codes
 	 */
 
-	var networkActorCodeMap = NetworkActorCodeMap{}
+	var networkActorCodeMap = descriptors.NetworkActorCodeMap{}
+	var networkActorCodeHistory = descriptors.NetworkActorCodeHistory{}
+	var actorDescriptorIndex = descriptors.ActorDescriptorIndex{}
+	var actorCodeIndex = descriptors.ActorCodeIndex{}
+	var includeNetwork = func(network dtypes.NetworkName) bool {
+		return len(networks) == 0 || networks.Contains(string(network))
+	}
 
-	for _, url := range apiUrls {
+	for _, bundle := range bundles {
+		if !includeNetwork(bundle.Network) {
+			continue
+		}
 
-		// Open Lotus API for network
-		var lotus Lotus
-		if err := lotus.Open(url); err != nil {
-			log.Fatalf("Failed to start Lotus API: %s", err)
+		// Store actor codes in map
+		networkActorCodeMap[bundle.Network] = bundle.NetworkManifest()
+		descriptors.AddActorCodes(actorCodeIndex, versionedActorDescriptorMap, bundle.Network, bundle.Version, bundle.ActorCodeMap)
+
+		// Index actor codes to versioned descriptors
+		if bundle.Version == 0 {
+			logf("Skipping descriptor index for %s: unknown bundle manifest %s", bundle.Network, bundle.ManifestCid)
+			continue
 		}
-		defer lotus.Close()
+		indexActorCodes(actorDescriptorIndex, versionedActorDescriptorMap, bundle.Network, bundle.Version, bundle.ActorCodeMap)
+	}
 
-		// Retrieve network name from Lotus
-		networkName, err := lotus.api.StateNetworkName(context.Background())
-		if err != nil {
-			log.Fatalf("Failed to get network name: %s", err)
+	// Retrieve actor codes from Lotus
+	var failures, historyFailures int
+	lotusResults := descriptors.FetchNetworkActorCodes(context.Background(), lotusEndpoints, descriptors.OpenLotusEndpoint)
+	for _, result := range lotusResults {
+		if result.Err != nil {
+			logf("Failed to get actor codes: %v", result.Err)
+			failures++
+			continue
+		}
+		if !includeNetwork(result.Network) {
+			continue
 		}
 
-		// Retrieve actor codes from Lotus
-		actorCodeMap, err := lotus.GetActorCodeMap()
-		if err != nil {
-			log.Fatalf("Failed to get actor codes: %v", err)
+		// Store actor codes in map, without the Eth chain ID if unavailable
+		if result.EthErr != nil {
+			logf("Skipping Eth chain ID for %s: %v", result.Network, result.EthErr)
 		}
+		networkActorCodeMap[result.Network] = result.Manifest
+		descriptors.AddActorCodes(actorCodeIndex, versionedActorDescriptorMap, result.Network, result.Manifest.Version, result.Manifest.ActorCodeMap)
 
-		// Store actor codes in map
-		networkActorCodeMap[networkName] = actorCodeMap
+		// Index actor codes to versioned descriptors
+		if result.VersionErr != nil {
+			logf("Skipping descriptor index for %s: %v", result.Network, result.VersionErr)
+			continue
+		}
+		indexActorCodes(actorDescriptorIndex, versionedActorDescriptorMap, result.Network, result.Manifest.Version, result.Manifest.ActorCodeMap)
 	}
 
-	// Write actor codes
-	if err := writeJsonFile(networkActorCodeMap, "actor-codes"); err != nil {
-		log.Fatalf("Failed to write actor codes to JSON file: %v", err)
+	// Index actor codes of past upgrades to versioned descriptors, without
+	// replacing the current ones
+	for _, result := range lotusResults {
+		if result.Err != nil || !includeNetwork(result.Network) {
+			continue
+		}
+		// Keep the upgrades that were read when others failed
+		if result.HistoryErr != nil {
+			logf("Failed to get actor codes history: %v", result.HistoryErr)
+			historyFailures++
+		}
+		if result.History == nil {
+			continue
+		}
+		networkActorCodeHistory[result.Network] = result.History
+		for _, historical := range result.History {
+			descriptors.AddActorCodes(actorCodeIndex, versionedActorDescriptorMap, result.Network, historical.Version, historical.ActorCodeMap)
+			historicalIndex := descriptors.ActorDescriptorIndex{}
+			indexActorCodes(historicalIndex, versionedActorDescriptorMap, result.Network, historical.Version, historical.ActorCodeMap)
+			for code, key := range historicalIndex {
+				if _, ok := actorDescriptorIndex[code]; !ok {
+					actorDescriptorIndex[code] = key
+				}
+			}
+		}
 	}
 
-	/*
-	 * Actor descriptors
-	 */
+	// Index actor codes of every actors version embedded in Lotus, so that
+	// codes that are no longer active resolve without history
+	for _, bundle := range descriptors.GetAllEmbeddedBundles() {
+		if includeNetwork(bundle.Network) {
+			descriptors.AddActorCodes(actorCodeIndex, versionedActorDescriptorMap, bundle.Network, bundle.Version, bundle.ActorCodeMap)
+		}
+	}
 
-	var actorDescriptorMap = ActorDescriptorMap{}
-	for name, reflectableActor := range reflectableActors {
+	// Write actor codes
+	if err := writeJsonFile(networkActorCodeMap, *outputDir, "actor-codes"); err != nil {
+		return fmt.Errorf("failed to write actor codes to JSON file: %w", err)
+	}
 
-		// State reflection
-		var actorState DataTypeMap = nil
-		if stateType := reflect.TypeOf(reflectableActor.State); stateType != nil {
-			stateDataType := GetDataType(stateType)
-			if stateDataType.Type != TypeObject {
-				log.Fatalf("%s actor state is not an object", name)
-			}
-			actorState = stateDataType.Children
+	// Write actor codes of past upgrades
+	if len(networkActorCodeHistory) > 0 {
+		if err := writeJsonFile(networkActorCodeHistory, *outputDir, "actor-codes-history"); err != nil {
+			return fmt.Errorf("failed to write actor codes history to JSON file: %w", err)
 		}
+	}
 
-		// Methods reflection
-		var actorMethodMap = ActorMethodMap{}
+	// Write actor descriptor index
+	if err := writeJsonFile(actorDescriptorIndex, *outputDir, "actor-descriptor-index"); err != nil {
+		return fmt.Errorf("failed to write actor descriptor index to JSON file: %w", err)
+	}
 
-		// Add Send method
-		if name != "system" {
-			emptyType := reflect.TypeOf((*abi.EmptyValue)(nil))
-			emptyDataType := GetDataType(emptyType)
-			actorMethodMap[0] = ActorMethod{
-				Name:   "Send",
-				Param:  emptyDataType,
-				Return: emptyDataType,
+	// Write code index
+	if err := writeJsonFile(actorCodeIndex, *outputDir, "code-index"); err != nil {
+		return fmt.Errorf("failed to write code index to JSON file: %w", err)
+	}
+
+	// Report failed networks after writing the others
+	if failures > 0 {
+		return fmt.Errorf("failed to get actor codes from %d of %d endpoints", failures, len(lotusEndpoints))
+	}
+	if historyFailures > 0 {
+		return fmt.Errorf("failed to get complete actor codes history from %d of %d endpoints", historyFailures, len(lotusEndpoints))
+	}
+
+	return nil
+}
+
+func runDescriptors(args []string) error {
+	var formats stringList
+	flags := newFlagSet("descriptors")
+	flags.Var(&formats, "format", "Output formats, may be repeated: json, schema, typescript (default all)")
+	outputDir := flags.String("output", "output", "Output directory")
+	configPath := flags.String("config", "", "Configuration file with outputs")
+	flags.Parse(args)
+	config, err := loadConfig(*configPath, flags, outputDir, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(formats) == 0 {
+		for _, format := range []string{"json", "schema", "typescript"} {
+			if config == nil || config.HasOutput(format) {
+				formats = append(formats, format)
 			}
 		}
+	}
+	for _, format := range formats {
+		if format != "json" && format != "schema" && format != "typescript" {
+			return fmt.Errorf("unknown format %s", format)
+		}
+	}
+	if len(formats) == 0 {
+		return nil
+	}
 
-		// Iterate over actor methods
-		for key, method := range reflectableActor.Methods {
-			var actorMethod ActorMethod
-			methodType := reflect.TypeOf(method)
-
-			if methodType.Name() == "CustomMethod" {
-				var customMethod = method.(CustomMethod)
-				actorMethod.Name = customMethod.Name
-				actorMethod.Param = GetDataType(reflect.TypeOf(customMethod.Param))
-				actorMethod.Return = GetDataType(reflect.TypeOf(customMethod.Return))
-			} else {
-				// Get method DataType
-				methodDataType := GetDataType(methodType)
-
-				// Set method name
-				fullName := runtime.FuncForPC(reflect.ValueOf(method).Pointer()).Name()
-				nameParts := strings.Split(fullName, ".")
-				actorMethod.Name = nameParts[len(nameParts)-1]
-
-				// Set method parameter
-				paramsCount := len(methodDataType.Params)
-				if paramsCount != 3 {
-					log.Fatalf("%s actor method %s has %d parameters, expected 3", name, method, paramsCount)
-				}
-				firstParamName := methodDataType.Params[0].Name
-				if firstParamName != "Actor" {
-					log.Fatalf("%s actor method %s has %s as first parameter, should be Actor", name, method, firstParamName)
-				}
-				secondParamName := methodDataType.Params[1].Name
-				if secondParamName != "Runtime" {
-					log.Fatalf("%s actor method %s has %s as first parameter, should be Runtime", name, method, secondParamName)
-				}
-				actorMethod.Param = methodDataType.Params[2]
+	/*
+	 * Actor descriptors
+	 */
 
-				// Set method return value
-				returnsCount := len(methodDataType.Returns)
-				if returnsCount != 1 {
-					log.Fatalf("%s actor method %s has %d return values, expected 1", name, method, returnsCount)
-				}
-				actorMethod.Return = methodDataType.Returns[0]
-			}
+	var dataTypeDefinitions = descriptors.DataTypeDefinitions{}
+	versionedActorDescriptorMap, err := descriptors.GetVersionedActorDescriptorMap(dataTypeDefinitions)
+	if err != nil {
+		return fmt.Errorf("failed to get actor descriptors: %w", err)
+	}
+
+	// Fail when go-state-types exports methods without descriptors
+	if err := descriptors.CheckCoverage(versionedActorDescriptorMap); err != nil {
+		return fmt.Errorf("incomplete actor descriptors: %w", err)
+	}
+
+	if formats.Contains("json") {
 
-			// Store method in map
-			actorMethodMap[key] = actorMethod
+		// Write actor descriptors to JSON file
+		if err := writeJsonFile(versionedActorDescriptorMap, *outputDir, "actor-descriptors"); err != nil {
+			return fmt.Errorf("failed to write actor descriptors to JSON file: %w", err)
 		}
 
-		// Set actor descriptor
-		actorDescriptorMap[name] = ActorDescriptor{
-			State:   actorState,
-			Methods: actorMethodMap,
+		// Write type definitions to JSON file
+		if err := writeJsonFile(dataTypeDefinitions, *outputDir, "type-definitions"); err != nil {
+			return fmt.Errorf("failed to write type definitions to JSON file: %w", err)
 		}
 	}
 
-	// Write actor descriptors to JSON file
-	if err := writeJsonFile(actorDescriptorMap, "actor-descriptors"); err != nil {
-		log.Fatalf("Failed to write actor descriptors to JSON file: %v", err)
+	/*
+	 * JSON schemas
+	 */
+
+	if formats.Contains("schema") {
+		if err := writeJsonSchemas(versionedActorDescriptorMap, dataTypeDefinitions, *outputDir); err != nil {
+			return fmt.Errorf("failed to write JSON schemas: %w", err)
+		}
 	}
 
 	/*
-	 * Done
+	 * TypeScript declarations
 	 */
 
-	fmt.Println("Done")
+	if formats.Contains("typescript") {
+		for version, actorDescriptorMap := range versionedActorDescriptorMap {
+			declarations, err := descriptors.GetTypeScriptDeclarations(actorDescriptorMap, dataTypeDefinitions)
+			if err != nil {
+				return fmt.Errorf("failed to get TypeScript declarations for actors version %d: %w", version, err)
+			}
+			if err := writeFile([]byte(declarations), *outputDir, fmt.Sprintf("typescript/v%d.d.ts", version)); err != nil {
+				return fmt.Errorf("failed to write TypeScript declarations: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func runGenerate(args []string) error {
+	flags := newFlagSet("generate")
+	configPath := flags.String("config", "", "Configuration file with networks and outputs")
+	flags.Parse(args)
+	if *configPath == "" {
+		flags.Usage()
+		return fmt.Errorf("missing configuration file")
+	}
+	config, err := descriptors.LoadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	if err := runDescriptors([]string{"-config", *configPath}); err != nil {
+		return err
+	}
+	if config.HasOutput("codes") {
+		return runCodes([]string{"-config", *configPath})
+	}
+	return nil
+}
+
+// Loads the configuration file if given, applying its settings to the
+// flags that were not set explicitly
+func loadConfig(path string, flags *flag.FlagSet, outputDir *string, timeout *time.Duration, retries *int) (*descriptors.Config, error) {
+	if path == "" {
+		return nil, nil
+	}
+	config, err := descriptors.LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	set := map[string]bool{}
+	flags.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if config.Output != "" && !set["output"] {
+		*outputDir = config.Output
+	}
+	if config.Timeout != nil && timeout != nil && !set["timeout"] {
+		*timeout = *config.Timeout
+	}
+	if config.Retries != nil && retries != nil && !set["retries"] {
+		*retries = *config.Retries
+	}
+
+	return config, nil
+}
+
+// Maps actor codes to the descriptors of their actors version
+func indexActorCodes(index descriptors.ActorDescriptorIndex, versionedActorDescriptorMap descriptors.VersionedActorDescriptorMap, network dtypes.NetworkName, version descriptors.ActorsVersion, actorCodeMap descriptors.ActorCodeMap) {
+	if _, ok := versionedActorDescriptorMap[version]; !ok {
+		logf("Skipping descriptor index for %s: no descriptors for actors version %d", network, version)
+		return
+	}
+	for name, code := range actorCodeMap {
+		index[code] = descriptors.ActorDescriptorKey{
+			Version: version,
+			Name:    name,
+		}
+	}
+}
+
+// Logs progress to stderr, keeping stdout for command output
+func logf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
 }
 
-func writeJsonFile(data interface{}, filename string) error {
+func writeJsonFile(data interface{}, dir string, filename string) error {
 
 	// Marshal data to JSON
 	dataJson, err := json.MarshalIndent(data, "", "  ")
@@ -169,17 +497,72 @@ func writeJsonFile(data interface{}, filename string) error {
 		return err
 	}
 
-	// Create file
-	f, err := os.Create(fmt.Sprintf("output/%s.json", filename))
+	return writeFile(dataJson, dir, filename+".json")
+}
+
+func writeFile(data []byte, dir string, filename string) error {
+
+	// Create file and directory
+	path := filepath.Join(dir, filename)
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
 	if err != nil {
 		return err
 	}
 	defer f.Close()
 
 	// Write file
-	if _, err = f.Write(dataJson); err != nil {
+	if _, err = f.Write(data); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// Writes a JSON schema for the state and each method param and return
+// value of every actor, to schemas/<version>/<actor>/
+func writeJsonSchemas(versionedActorDescriptorMap descriptors.VersionedActorDescriptorMap, definitions descriptors.DataTypeDefinitions, outputDir string) error {
+	for version, actorDescriptorMap := range versionedActorDescriptorMap {
+		for name, descriptor := range actorDescriptorMap {
+			dir := fmt.Sprintf("schemas/v%d/%s", version, name)
+
+			// State schema
+			if descriptor.State != nil {
+				stateDataType := descriptors.GetStateDataType(descriptor.State)
+				title := fmt.Sprintf("%s v%d state", name, version)
+				schema, err := descriptors.GetJsonSchema(stateDataType, definitions, title)
+				if err != nil {
+					return fmt.Errorf("%s: %w", title, err)
+				}
+				if err := writeJsonFile(schema, outputDir, dir+"/state"); err != nil {
+					return err
+				}
+			}
+
+			// Method schemas
+			for methodNum, method := range descriptor.Methods {
+				title := fmt.Sprintf("%s v%d %s params", name, version, method.Name)
+				schema, err := descriptors.GetJsonSchema(method.Param, definitions, title)
+				if err != nil {
+					return fmt.Errorf("%s: %w", title, err)
+				}
+				if err := writeJsonFile(schema, outputDir, fmt.Sprintf("%s/%d.param", dir, methodNum)); err != nil {
+					return err
+				}
+
+				title = fmt.Sprintf("%s v%d %s return", name, version, method.Name)
+				schema, err = descriptors.GetJsonSchema(method.Return, definitions, title)
+				if err != nil {
+					return fmt.Errorf("%s: %w", title, err)
+				}
+				if err := writeJsonFile(schema, outputDir, fmt.Sprintf("%s/%d.return", dir, methodNum)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
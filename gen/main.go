@@ -0,0 +1,713 @@
+// Generates descriptors/actors.go, the registry of reflectable actors, from
+// the builtin actor packages of each actors version in go-state-types. Run
+// it through go generate after upgrading go-state-types.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/manifest"
+	"golang.org/x/tools/go/packages"
+)
+
+const builtinPackage = "github.com/filecoin-project/go-state-types/builtin"
+
+// First actors version with per-version Methods tables in go-state-types
+const firstActorsVersion = 8
+
+// Package name by manifest actor name
+var actorPackages = map[string]string{
+	manifest.AccountKey:     "account",
+	manifest.CronKey:        "cron",
+	manifest.DatacapKey:     "datacap",
+	manifest.EamKey:         "eam",
+	manifest.EthAccountKey:  "ethaccount",
+	manifest.EvmKey:         "evm",
+	manifest.InitKey:        "init",
+	manifest.MarketKey:      "market",
+	manifest.MinerKey:       "miner",
+	manifest.MultisigKey:    "multisig",
+	manifest.PaychKey:       "paych",
+	manifest.PlaceholderKey: "placeholder",
+	manifest.PowerKey:       "power",
+	manifest.RewardKey:      "reward",
+	manifest.SystemKey:      "system",
+	manifest.VerifregKey:    "verifreg",
+}
+
+// Builtin method numbers by manifest actor name, the system actor has none
+var builtinMethods = map[string]string{
+	manifest.AccountKey:     "MethodsAccount",
+	manifest.CronKey:        "MethodsCron",
+	manifest.DatacapKey:     "MethodsDatacap",
+	manifest.EamKey:         "MethodsEAM",
+	manifest.EthAccountKey:  "MethodsEthAccount",
+	manifest.EvmKey:         "MethodsEVM",
+	manifest.InitKey:        "MethodsInit",
+	manifest.MarketKey:      "MethodsMarket",
+	manifest.MinerKey:       "MethodsMiner",
+	manifest.MultisigKey:    "MethodsMultisig",
+	manifest.PaychKey:       "MethodsPaych",
+	manifest.PlaceholderKey: "MethodsPlaceholder",
+	manifest.PowerKey:       "MethodsPower",
+	manifest.RewardKey:      "MethodsReward",
+	manifest.VerifregKey:    "MethodsVerifiedRegistry",
+}
+
+// Collection roots of state fields, which go-state-types only declares
+// as CIDs. Bare value and key types are declared by the actor package.
+type ContainerSpec struct {
+	Kind        string // Hamt, Amt or Kamt
+	BitWidth    int
+	KeyEncoding string // Address, Uvarint, Varint, Cid, Cbor or Bytes
+	Key         string
+	Value       string // None for sets
+	Nested      *ContainerSpec
+}
+
+type ContainerField struct {
+	Actor  string
+	Struct string
+	Field  string
+	ContainerSpec
+}
+
+var containerFields = []ContainerField{
+	{manifest.DatacapKey, "TokenState", "Balances", ContainerSpec{Kind: "Hamt", BitWidth: 3, KeyEncoding: "Uvarint", Key: "abi.ActorID", Value: "abi.TokenAmount"}},
+	{manifest.DatacapKey, "TokenState", "Allowances", ContainerSpec{Kind: "Hamt", BitWidth: 3, KeyEncoding: "Uvarint", Key: "abi.ActorID",
+		Nested: &ContainerSpec{Kind: "Hamt", BitWidth: 3, KeyEncoding: "Uvarint", Key: "abi.ActorID", Value: "abi.TokenAmount"}}},
+	{manifest.EvmKey, "State", "ContractState", ContainerSpec{Kind: "Kamt", BitWidth: 5, KeyEncoding: "Bytes", Key: "[]byte", Value: "[]byte"}},
+	{manifest.InitKey, "State", "AddressMap", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Address", Key: "address.Address", Value: "abi.ActorID"}},
+	{manifest.MarketKey, "State", "Proposals", ContainerSpec{Kind: "Amt", BitWidth: 5, Value: "DealProposal"}},
+	{manifest.MarketKey, "State", "States", ContainerSpec{Kind: "Amt", BitWidth: 6, Value: "DealState"}},
+	{manifest.MarketKey, "State", "PendingProposals", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Cid", Key: "cid.Cid"}},
+	{manifest.MarketKey, "State", "EscrowTable", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Address", Key: "address.Address", Value: "abi.TokenAmount"}},
+	{manifest.MarketKey, "State", "LockedTable", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Address", Key: "address.Address", Value: "abi.TokenAmount"}},
+	{manifest.MarketKey, "State", "DealOpsByEpoch", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Uvarint", Key: "abi.ChainEpoch",
+		Nested: &ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Uvarint", Key: "abi.DealID"}}},
+	{manifest.MarketKey, "State", "PendingDealAllocationIds", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Uvarint", Key: "abi.DealID", Value: "int64"}},
+	{manifest.MinerKey, "State", "PreCommittedSectors", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Uvarint", Key: "abi.SectorNumber", Value: "SectorPreCommitOnChainInfo"}},
+	{manifest.MinerKey, "State", "PreCommittedSectorsCleanUp", ContainerSpec{Kind: "Amt", BitWidth: 6, Value: "bitfield.BitField"}},
+	{manifest.MinerKey, "State", "Sectors", ContainerSpec{Kind: "Amt", BitWidth: 5, Value: "SectorOnChainInfo"}},
+	{manifest.MultisigKey, "State", "PendingTxns", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Varint", Key: "TxnID", Value: "Transaction"}},
+	{manifest.PaychKey, "State", "LaneStates", ContainerSpec{Kind: "Amt", BitWidth: 3, Value: "LaneState"}},
+	{manifest.PowerKey, "State", "CronEventQueue", ContainerSpec{Kind: "Hamt", BitWidth: 6, KeyEncoding: "Varint", Key: "abi.ChainEpoch",
+		Nested: &ContainerSpec{Kind: "Amt", BitWidth: 6, Value: "CronEvent"}}},
+	{manifest.PowerKey, "State", "Claims", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Address", Key: "address.Address", Value: "Claim"}},
+	{manifest.PowerKey, "State", "ProofValidationBatch", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Address", Key: "address.Address",
+		Nested: &ContainerSpec{Kind: "Amt", BitWidth: 4, Value: "proof.SealVerifyInfo"}}},
+	{manifest.VerifregKey, "State", "Verifiers", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Address", Key: "address.Address", Value: "DataCap"}},
+	{manifest.VerifregKey, "State", "VerifiedClients", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Address", Key: "address.Address", Value: "DataCap"}},
+	{manifest.VerifregKey, "State", "RemoveDataCapProposalIDs", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Cbor", Key: "abi.AddrPairKey", Value: "RmDcProposalID"}},
+	{manifest.VerifregKey, "State", "Allocations", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Uvarint", Key: "abi.ActorID",
+		Nested: &ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Uvarint", Key: "AllocationId", Value: "Allocation"}}},
+	{manifest.VerifregKey, "State", "Claims", ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Uvarint", Key: "abi.ActorID",
+		Nested: &ContainerSpec{Kind: "Hamt", BitWidth: 5, KeyEncoding: "Uvarint", Key: "ClaimId", Value: "Claim"}}},
+}
+
+// Semantics of go-state-types aliases, which reflection cannot tell from
+// the aliased type, by their name in the source
+var aliasSemantics = map[string]string{
+	"abi.TokenAmount":   "TokenAmount",
+	"abi.StoragePower":  "StoragePower",
+	"abi.DealWeight":    "DealWeight",
+	"abi.SectorQuality": "SectorQuality",
+	"abi.PeerID":        "PeerID",
+	"abi.Multiaddrs":    "Multiaddr",
+}
+
+// Semantics of fields declared without their alias
+var fieldSemantics = []SemanticField{
+	{manifest.MinerKey, "GetPeerIDReturn", "PeerId", "PeerID"},
+}
+
+type SemanticField struct {
+	Actor    string
+	Struct   string
+	Field    string
+	Semantic string
+}
+
+// Semantics of actor package types with custom marshalling, whose codecs
+// are registered by semantic
+var typeSemantics = []SemanticType{
+	{manifest.MarketKey, "DealLabel", "DealLabel"},
+	{manifest.EvmKey, "GetBytecodeReturn", "BytecodeReturn"},
+}
+
+type SemanticType struct {
+	Actor    string
+	Type     string
+	Semantic string
+}
+
+// Integer types whose values are named by constants of their package.
+// Exit codes and network versions beyond the named ones are valid too.
+// Network versions keep their prefix, as their names are numbers without.
+type EnumSpec struct {
+	Package string
+	Name    string
+	Prefix  string // Trimmed off the constant names, such as SigType of SigTypeBLS
+	Open    bool
+	Skip    []string // Constants that are bounds or sentinels rather than values
+}
+
+var enumSpecs = []EnumSpec{
+	{"github.com/filecoin-project/go-state-types/abi", "RegisteredSealProof", "RegisteredSealProof_", false, nil},
+	{"github.com/filecoin-project/go-state-types/abi", "RegisteredPoStProof", "RegisteredPoStProof_", false, nil},
+	{"github.com/filecoin-project/go-state-types/abi", "RegisteredAggregationProof", "RegisteredAggregationProof_", false, nil},
+	{"github.com/filecoin-project/go-state-types/abi", "RegisteredUpdateProof", "RegisteredUpdateProof_", false, nil},
+	{"github.com/filecoin-project/go-state-types/crypto", "SigType", "SigType", false, []string{"SigTypeUnknown"}},
+	{"github.com/filecoin-project/go-state-types/exitcode", "ExitCode", "", true, []string{"FirstActorErrorCode", "FirstActorSpecificExitCode"}},
+	{"github.com/filecoin-project/go-state-types/network", "Version", "", true, []string{"VersionMax"}},
+}
+
+var versionDirPattern = regexp.MustCompile(`^v([0-9]+)$`)
+var localTypePattern = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+type Actor struct {
+	Name       string
+	Alias      string
+	Path       string
+	HasState   bool
+	Containers []Container
+	Semantics  []Semantic
+	Types      []Semantic // Semantics of types rather than fields
+}
+
+// Semantic of a struct field or type of an actor package
+type Semantic struct {
+	TypeId   string
+	Semantic string
+}
+
+// Container field of an actor package, with types qualified by the alias
+type Container struct {
+	TypeId string
+	ContainerSpec
+}
+
+type Version struct {
+	Version int
+	Actors  []Actor
+}
+
+// Named values of an enum-like type, sorted by value
+type Enum struct {
+	TypeId string
+	Open   bool
+	Values []EnumValue
+}
+
+type EnumValue struct {
+	Name  string
+	Value int64
+}
+
+type Registry struct {
+	Versions       []Version
+	Enums          []Enum
+	BuiltinMethods map[string]string
+}
+
+func main() {
+
+	// Locate go-state-types builtin actor packages
+	out, err := exec.Command("go", "list", "-f", "{{.Dir}}", builtinPackage).Output()
+	if err != nil {
+		log.Fatalf("Failed to locate %s: %v", builtinPackage, err)
+	}
+	builtinDir := strings.TrimSpace(string(out))
+
+	// Find actors versions
+	entries, err := os.ReadDir(builtinDir)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", builtinDir, err)
+	}
+	var registry = Registry{BuiltinMethods: builtinMethods}
+	for _, entry := range entries {
+		match := versionDirPattern.FindStringSubmatch(entry.Name())
+		if !entry.IsDir() || match == nil {
+			continue
+		}
+		version, _ := strconv.Atoi(match[1])
+		if version < firstActorsVersion {
+			continue
+		}
+		actors, err := getActors(builtinDir, version)
+		if err != nil {
+			log.Fatalf("Failed to get actors for version %d: %v", version, err)
+		}
+		registry.Versions = append(registry.Versions, Version{Version: version, Actors: actors})
+	}
+	sort.Slice(registry.Versions, func(i, j int) bool {
+		return registry.Versions[i].Version < registry.Versions[j].Version
+	})
+
+	if err := checkContainerFields(registry.Versions); err != nil {
+		log.Fatalf("Failed to get containers: %v", err)
+	}
+	if registry.Enums, err = getEnums(); err != nil {
+		log.Fatalf("Failed to get enums: %v", err)
+	}
+
+	// Render and format registry
+	var buf bytes.Buffer
+	if err := registryTemplate.Execute(&buf, registry); err != nil {
+		log.Fatalf("Failed to render registry: %v", err)
+	}
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format registry: %v", err)
+	}
+
+	if err := os.WriteFile("actors.go", source, 0644); err != nil {
+		log.Fatalf("Failed to write actors.go: %v", err)
+	}
+}
+
+func getActors(builtinDir string, version int) ([]Actor, error) {
+	var actors []Actor
+	for _, name := range manifest.GetBuiltinActorsKeys(actorstypes.Version(version)) {
+		pkg, ok := actorPackages[name]
+		if !ok {
+			return nil, fmt.Errorf("no package for actor %s", name)
+		}
+
+		// Every actor package declares a Methods table
+		dir := filepath.Join(builtinDir, fmt.Sprintf("v%d", version), pkg)
+		if _, err := os.Stat(filepath.Join(dir, "methods.go")); err != nil {
+			return nil, err
+		}
+
+		pkgTypes, err := parsePackageTypes(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		// Not every actor has state
+		actor := Actor{
+			Name:     name,
+			Alias:    fmt.Sprintf("%s%d", pkg, version),
+			Path:     fmt.Sprintf("%s/v%d/%s", builtinPackage, version, pkg),
+			HasState: pkgTypes.Structs["State"] != nil,
+		}
+		if actor.Containers, err = getContainers(pkgTypes, actor); err != nil {
+			return nil, err
+		}
+		actor.Semantics = getSemantics(pkgTypes, actor)
+		actor.Types = getTypeSemantics(pkgTypes, actor)
+		actors = append(actors, actor)
+	}
+	sort.Slice(actors, func(i, j int) bool { return actors[i].Name < actors[j].Name })
+	return actors, nil
+}
+
+// Types an actor package declares
+type PackageTypes struct {
+	Structs map[string]*ast.StructType
+	Aliases map[string]string // Aliased type names by alias
+	Names   map[string]bool   // Names of all types
+}
+
+// Parses the type declarations of the non-test sources of a package
+func parsePackageTypes(dir string) (PackageTypes, error) {
+	pkgTypes := PackageTypes{
+		Structs: map[string]*ast.StructType{},
+		Aliases: map[string]string{},
+		Names:   map[string]bool{},
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return pkgTypes, err
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					pkgTypes.Names[typeSpec.Name.Name] = true
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+						pkgTypes.Structs[typeSpec.Name.Name] = structType
+					} else if typeSpec.Assign.IsValid() {
+						pkgTypes.Aliases[typeSpec.Name.Name] = typeName(typeSpec.Type)
+					}
+				}
+			}
+		}
+	}
+	return pkgTypes, nil
+}
+
+// Returns the container fields the actor package declares as CIDs in
+// their struct, checking that their types exist in the package
+func getContainers(pkgTypes PackageTypes, actor Actor) ([]Container, error) {
+	var containers []Container
+	for _, field := range containerFields {
+		if field.Actor != actor.Name {
+			continue
+		}
+		fieldType, ok := findStructField(pkgTypes.Structs[field.Struct], field.Field)
+		if !ok {
+			continue
+		}
+		if name := typeName(elemType(fieldType)); name != "cid.Cid" {
+			return nil, fmt.Errorf("%s.%s: expected cid.Cid, got %s", field.Struct, field.Field, name)
+		}
+		spec, err := qualifyContainerSpec(field.ContainerSpec, actor.Alias, pkgTypes)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", field.Struct, field.Field, err)
+		}
+		containers = append(containers, Container{
+			TypeId:        fmt.Sprintf("%s.%s.%s", actor.Path, field.Struct, field.Field),
+			ContainerSpec: spec,
+		})
+	}
+	return containers, nil
+}
+
+// Returns the type of a field of a struct, if both exist
+func findStructField(structType *ast.StructType, name string) (ast.Expr, bool) {
+	if structType == nil {
+		return nil, false
+	}
+	for _, field := range structType.Fields.List {
+		for _, fieldName := range field.Names {
+			if fieldName.Name == name {
+				return field.Type, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Fails for container fields that no actors version declares, which are
+// misspelled or renamed in go-state-types
+func checkContainerFields(versions []Version) error {
+	for _, field := range containerFields {
+		suffix := fmt.Sprintf(".%s.%s", field.Struct, field.Field)
+		var found bool
+		for _, version := range versions {
+			for _, actor := range version.Actors {
+				for _, container := range actor.Containers {
+					found = found || (actor.Name == field.Actor && strings.HasSuffix(container.TypeId, suffix))
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("no actors version declares %s %s%s", field.Actor, field.Struct, suffix)
+		}
+	}
+	return nil
+}
+
+func qualifyContainerSpec(spec ContainerSpec, alias string, pkgTypes PackageTypes) (ContainerSpec, error) {
+	for _, t := range []*string{&spec.Key, &spec.Value} {
+		if !localTypePattern.MatchString(*t) {
+			continue
+		}
+		if !pkgTypes.Names[*t] {
+			return spec, fmt.Errorf("no type %s", *t)
+		}
+		*t = alias + "." + *t
+	}
+	if spec.Nested != nil {
+		nested, err := qualifyContainerSpec(*spec.Nested, alias, pkgTypes)
+		if err != nil {
+			return spec, err
+		}
+		spec.Nested = &nested
+	}
+	return spec, nil
+}
+
+// Returns the semantics of the struct fields the actor package declares
+// with an alias, directly or through a local alias, or lists explicitly
+func getSemantics(pkgTypes PackageTypes, actor Actor) []Semantic {
+	aliases, structs := pkgTypes.Aliases, pkgTypes.Structs
+
+	var semantics []Semantic
+	var names []string
+	for name := range structs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, field := range structs[name].Fields.List {
+			elem := typeName(elemType(field.Type))
+			if alias, ok := aliases[elem]; ok {
+				elem = alias
+			}
+			semantic, ok := aliasSemantics[elem]
+			if !ok {
+				continue
+			}
+			for _, fieldName := range field.Names {
+				semantics = append(semantics, Semantic{
+					TypeId:   fmt.Sprintf("%s.%s.%s", actor.Path, name, fieldName.Name),
+					Semantic: semantic,
+				})
+			}
+		}
+	}
+	for _, field := range fieldSemantics {
+		if field.Actor == actor.Name && structs[field.Struct] != nil {
+			semantics = append(semantics, Semantic{
+				TypeId:   fmt.Sprintf("%s.%s.%s", actor.Path, field.Struct, field.Field),
+				Semantic: field.Semantic,
+			})
+		}
+	}
+	return semantics
+}
+
+// Returns the semantics of the types the actor package declares
+func getTypeSemantics(pkgTypes PackageTypes, actor Actor) []Semantic {
+	var semantics []Semantic
+	for _, spec := range typeSemantics {
+		if spec.Actor == actor.Name && pkgTypes.Names[spec.Type] {
+			semantics = append(semantics, Semantic{
+				TypeId:   fmt.Sprintf("%s.%s", actor.Path, spec.Type),
+				Semantic: spec.Semantic,
+			})
+		}
+	}
+	return semantics
+}
+
+// Returns the values of the enum types, named by the exported constants
+// of their type. Values named more than once keep their first name in
+// the source, as later ones are deprecated aliases.
+func getEnums() ([]Enum, error) {
+	var paths []string
+	for _, spec := range enumSpecs {
+		paths = append(paths, spec.Package)
+	}
+
+	// Type check the packages with their dependencies, keeping positions in
+	// fset to order constants by
+	fset := token.NewFileSet()
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedTypes | packages.NeedImports | packages.NeedDeps, Fset: fset}, paths...)
+	if err != nil {
+		return nil, err
+	}
+	var pkgMap = map[string]*types.Package{}
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("%s: %v", pkg.PkgPath, pkg.Errors[0])
+		}
+		pkgMap[pkg.PkgPath] = pkg.Types
+	}
+
+	var enums []Enum
+	for _, spec := range enumSpecs {
+		pkg := pkgMap[spec.Package]
+		typeName, ok := pkg.Scope().Lookup(spec.Name).(*types.TypeName)
+		if !ok {
+			return nil, fmt.Errorf("no type %s.%s", spec.Package, spec.Name)
+		}
+
+		// Collect constants of the type in source order
+		var consts []*types.Const
+		for _, name := range pkg.Scope().Names() {
+			c, ok := pkg.Scope().Lookup(name).(*types.Const)
+			if ok && c.Exported() && types.Identical(c.Type(), typeName.Type()) && !contains(spec.Skip, name) {
+				consts = append(consts, c)
+			}
+		}
+		sort.SliceStable(consts, func(i, j int) bool {
+			a, b := fset.Position(consts[i].Pos()), fset.Position(consts[j].Pos())
+			if a.Filename != b.Filename {
+				return a.Filename < b.Filename
+			}
+			if a.Line != b.Line {
+				return a.Line < b.Line
+			}
+			return a.Column < b.Column
+		})
+
+		enum := Enum{TypeId: spec.Package + "." + spec.Name, Open: spec.Open}
+		var named = map[int64]bool{}
+		for _, c := range consts {
+			value, exact := constant.Int64Val(c.Val())
+			if !exact {
+				return nil, fmt.Errorf("%s.%s overflows int64", spec.Package, c.Name())
+			}
+			if named[value] {
+				continue
+			}
+			named[value] = true
+			enum.Values = append(enum.Values, EnumValue{
+				Name:  strings.TrimPrefix(c.Name(), spec.Prefix),
+				Value: value,
+			})
+		}
+		if len(enum.Values) == 0 {
+			return nil, fmt.Errorf("no constants of type %s.%s", spec.Package, spec.Name)
+		}
+		sort.Slice(enum.Values, func(i, j int) bool { return enum.Values[i].Value < enum.Values[j].Value })
+		enums = append(enums, enum)
+	}
+	return enums, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, elem := range list {
+		if elem == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Strips pointers and slices off a type expression
+func elemType(expr ast.Expr) ast.Expr {
+	for {
+		switch t := expr.(type) {
+		case *ast.StarExpr:
+			expr = t.X
+		case *ast.ArrayType:
+			expr = t.Elt
+		default:
+			return expr
+		}
+	}
+}
+
+// Returns the name of a type expression, such as abi.TokenAmount
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		if x, ok := t.X.(*ast.Ident); ok {
+			return x.Name + "." + t.Sel.Name
+		}
+	}
+	return ""
+}
+
+var containerTemplate = `{{ define "container" }}containerField{
+		Kind:     Container{{ .Kind }},
+		BitWidth: {{ .BitWidth }},
+		{{- if .KeyEncoding }}
+		KeyEncoding: Key{{ .KeyEncoding }},
+		Key: reflect.TypeOf((*{{ .Key }})(nil)).Elem(),
+		{{- end }}
+		{{- if .Value }}
+		Value: reflect.TypeOf((*{{ .Value }})(nil)).Elem(),
+		{{- end }}
+		{{- if .Nested }}
+		Nested: &{{ template "container" .Nested }},
+		{{- end }}
+	}{{ end }}`
+
+var registryTemplate = template.Must(template.Must(template.New("registry").Parse(containerTemplate)).Parse(`// Code generated by gen/main.go. DO NOT EDIT.
+
+package descriptors
+
+import (
+	"reflect"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-bitfield"
+	actorstypes "github.com/filecoin-project/go-state-types/actors"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/builtin"
+	"github.com/filecoin-project/go-state-types/proof"
+	"github.com/ipfs/go-cid"
+{{- range .Versions }}{{ range .Actors }}
+	{{ .Alias }} "{{ .Path }}"
+{{- end }}{{ end }}
+)
+
+type ReflectableActor struct {
+	State   interface{}
+	Methods map[abi.MethodNum]builtin.MethodMeta
+}
+
+type ReflectableActorMap = map[ActorName]ReflectableActor
+
+// Actors of each actors version, with their state type and methods
+var ReflectableActors = map[ActorsVersion]ReflectableActorMap{
+{{- range .Versions }}
+	actorstypes.Version{{ .Version }}: {
+	{{- range .Actors }}
+		"{{ .Name }}": {
+			State:   {{ if .HasState }}(*{{ .Alias }}.State)(nil){{ else }}nil{{ end }},
+			Methods: {{ .Alias }}.Methods,
+		},
+	{{- end }}
+	},
+{{- end }}
+}
+
+// Collection roots of state fields by type ID and field name
+var containerFields = map[TypeId]containerField{
+{{- range .Versions }}{{ range .Actors }}{{ range .Containers }}
+	"{{ .TypeId }}": {{ template "container" .ContainerSpec }},
+{{- end }}{{ end }}{{ end }}
+}
+
+// Semantics of struct fields declared with an alias, by type ID and field name
+var semanticFields = map[TypeId]string{
+{{- range .Versions }}{{ range .Actors }}{{ range .Semantics }}
+	"{{ .TypeId }}": Semantic{{ .Semantic }},
+{{- end }}{{ end }}{{ end }}
+}
+
+// Semantics of actor package types with custom marshalling by type ID
+var semanticTypes = map[TypeId]string{
+{{- range .Versions }}{{ range .Actors }}{{ range .Types }}
+	"{{ .TypeId }}": Semantic{{ .Semantic }},
+{{- end }}{{ end }}{{ end }}
+}
+
+// Named values of enum-like integer types by type ID
+var enumTypes = map[TypeId]enumType{
+{{- range .Enums }}
+	"{{ .TypeId }}": {
+		Open: {{ .Open }},
+		Values: []EnumValue{
+		{{- range .Values }}
+			{Name: "{{ .Name }}", Value: {{ .Value }}},
+		{{- end }}
+		},
+	},
+{{- end }}
+}
+
+// Method numbers exported by the latest actors version
+var builtinMethods = map[ActorName]interface{}{
+{{- range $name, $methods := .BuiltinMethods }}
+	"{{ $name }}": builtin.{{ $methods }},
+{{- end }}
+}
+`))
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGetEnums(t *testing.T) {
+	enums, err := getEnums()
+	if err != nil {
+		t.Fatal(err)
+	}
+	enumMap := map[string]Enum{}
+	for _, enum := range enums {
+		enumMap[enum.TypeId] = enum
+	}
+	if len(enumMap) != len(enumSpecs) {
+		t.Fatalf("got %d enums, expected %d", len(enumMap), len(enumSpecs))
+	}
+
+	tests := []struct {
+		typeId  string
+		open    bool
+		value   int64
+		name    string
+		skipped []int64 // Values of skipped constants
+	}{
+		{"github.com/filecoin-project/go-state-types/abi.RegisteredSealProof", false, 8, "StackedDrg32GiBV1_1", nil},
+		{"github.com/filecoin-project/go-state-types/crypto.SigType", false, 2, "BLS", []int64{math.MaxUint8}},
+		{"github.com/filecoin-project/go-state-types/exitcode.ExitCode", true, 16, "ErrIllegalArgument", []int64{32}},
+		{"github.com/filecoin-project/go-state-types/network.Version", true, 18, "Version18", []int64{math.MaxUint32}},
+	}
+	for _, test := range tests {
+		enum, ok := enumMap[test.typeId]
+		if !ok {
+			t.Errorf("missing enum %s", test.typeId)
+			continue
+		}
+		if enum.Open != test.open {
+			t.Errorf("%s: got open %t, expected %t", test.typeId, enum.Open, test.open)
+		}
+
+		// Values are sorted and named once
+		names := map[int64]string{}
+		for i, value := range enum.Values {
+			if i > 0 && value.Value <= enum.Values[i-1].Value {
+				t.Errorf("%s: value %d out of order", test.typeId, value.Value)
+			}
+			names[value.Value] = value.Name
+		}
+		if names[test.value] != test.name {
+			t.Errorf("%s: got name %q for %d, expected %q", test.typeId, names[test.value], test.value, test.name)
+		}
+		for _, skipped := range test.skipped {
+			if name, ok := names[skipped]; ok {
+				t.Errorf("%s: got skipped constant %s", test.typeId, name)
+			}
+		}
+	}
+}